@@ -0,0 +1,163 @@
+package gundyr
+
+import (
+	"errors"
+	"fmt"
+	"github.com/kelr/gundyr/helix"
+	"sync"
+	"time"
+)
+
+const (
+	defaultAdPollPeriod = time.Minute
+)
+
+// AdScheduler runs commercials on a channel at a regular interval while the channel is live.
+// The first commercial runs one interval after the stream started, and each following commercial
+// runs one interval after the previous one. The retry_after cooldown returned by Twitch is always honored.
+type AdScheduler struct {
+	BroadcasterID string
+	Interval      time.Duration
+	Length        int
+	PollPeriod    time.Duration
+
+	// OnCommercial is called after each commercial is started, if set.
+	OnCommercial func(*helix.StartCommercialData)
+
+	client     helixClient
+	now        func() time.Time
+	lastAd     time.Time
+	retryAfter time.Time
+	failures   uint
+	stop       chan bool
+	done       chan bool
+	mu         *sync.Mutex
+}
+
+// NewAdScheduler returns an AdScheduler that runs commercials of length seconds every interval
+// on the channel of broadcasterID. The live state of the channel is checked every minute.
+// Requires scope: channel:edit:commercial
+func (c *Helix) NewAdScheduler(broadcasterID string, interval time.Duration, length int) *AdScheduler {
+	return &AdScheduler{
+		BroadcasterID: broadcasterID,
+		Interval:      interval,
+		Length:        length,
+		PollPeriod:    defaultAdPollPeriod,
+		client:        c.client,
+		now:           time.Now,
+		mu:            &sync.Mutex{},
+	}
+}
+
+// Start begins checking the channel and running commercials in the background.
+// Returns an error if the scheduler is already running or is misconfigured.
+func (s *AdScheduler) Start() error {
+	if s.Length < helix.MinCommercialLength || s.Length > helix.MaxCommercialLength {
+		return errors.New("Ad scheduler commercial length must be between 30 and 180 seconds")
+	}
+	if s.Interval <= 0 || s.PollPeriod <= 0 {
+		return errors.New("Ad scheduler interval and poll period must be positive")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.stop != nil {
+		return errors.New("Ad scheduler is already running")
+	}
+	s.stop = make(chan bool)
+	s.done = make(chan bool)
+	go s.run(s.stop, s.done)
+	return nil
+}
+
+// Stop stops the scheduler and waits for a check in progress to finish, so no commercial is started
+// after Stop returns. Must not be called from OnCommercial. Returns an error if the scheduler is not running.
+func (s *AdScheduler) Stop() error {
+	s.mu.Lock()
+	stop, done := s.stop, s.done
+	s.stop, s.done = nil, nil
+	s.mu.Unlock()
+
+	if stop == nil {
+		return errors.New("Ad scheduler is not running")
+	}
+	close(stop)
+	<-done
+	return nil
+}
+
+func (s *AdScheduler) run(stop chan bool, done chan bool) {
+	defer close(done)
+	ticker := time.NewTicker(s.PollPeriod)
+	defer ticker.Stop()
+	for {
+		s.tick(s.now())
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// tick checks whether the channel is live and starts a commercial if one is due at now.
+func (s *AdScheduler) tick(now time.Time) {
+	response, err := s.client.GetStreams(&helix.GetStreamsOpt{
+		UserID: []string{s.BroadcasterID},
+	})
+	if err != nil {
+		fmt.Println("Ad scheduler failed to get stream state:", err)
+		return
+	}
+
+	var startedAt time.Time
+	for _, stream := range response.Data {
+		if stream.UserID == s.BroadcasterID && stream.Type == "live" {
+			startedAt, _ = time.Parse(time.RFC3339, stream.StartedAt)
+			if startedAt.IsZero() {
+				startedAt = now
+			}
+		}
+	}
+
+	// Forget the previous commercial once the stream ends so the next stream starts a fresh interval.
+	if startedAt.IsZero() {
+		s.lastAd = time.Time{}
+		s.failures = 0
+		return
+	}
+	if s.lastAd.Before(startedAt) {
+		s.lastAd = startedAt
+	}
+	if now.Sub(s.lastAd) < s.Interval || now.Before(s.retryAfter) {
+		return
+	}
+
+	commercial, err := s.client.StartCommercial(&helix.StartCommercialBody{
+		BroadcasterID: s.BroadcasterID,
+		Length:        s.Length,
+	})
+	if err == nil && len(commercial.Data) == 0 {
+		err = errors.New("no commercial was started")
+	}
+	if err != nil {
+		// Back off exponentially from the poll period, up to the interval, until a commercial succeeds.
+		backoff := s.PollPeriod << s.failures
+		if backoff >= s.Interval || backoff <= 0 {
+			backoff = s.Interval
+		} else {
+			s.failures++
+		}
+		s.retryAfter = now.Add(backoff)
+		fmt.Println("Ad scheduler failed to start commercial, retrying in", backoff.String()+":", err)
+		return
+	}
+
+	data := commercial.Data[0]
+	s.failures = 0
+	s.lastAd = now
+	s.retryAfter = now.Add(time.Duration(data.RetryAfter) * time.Second)
+	if s.OnCommercial != nil {
+		s.OnCommercial(&data)
+	}
+}
@@ -0,0 +1,160 @@
+package gundyr
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kelr/gundyr/helix"
+)
+
+// Tests that commercials run one interval after the stream starts and after each other, honoring
+// retry_after and backing off after failures and empty responses.
+func TestAdSchedulerTick(t *testing.T) {
+	empty := errors.New("empty response")
+	start := time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+	live := true
+	var commercialErr error
+	var commercials []time.Time
+	var now time.Time
+
+	mock := &mockHelixClient{
+		getStreams: func(opt *helix.GetStreamsOpt) (*helix.GetStreamsResponse, error) {
+			if !live {
+				return &helix.GetStreamsResponse{}, nil
+			}
+			return &helix.GetStreamsResponse{Data: []helix.GetStreamsData{
+				{UserID: "1337", Type: "live", StartedAt: start.Format(time.RFC3339)},
+			}}, nil
+		},
+		startCommercial: func(body *helix.StartCommercialBody) (*helix.StartCommercialResponse, error) {
+			if body.BroadcasterID != "1337" || body.Length != 60 {
+				t.Error("unexpected commercial:", body)
+			}
+			commercials = append(commercials, now)
+			if commercialErr == empty {
+				return &helix.StartCommercialResponse{}, nil
+			}
+			if commercialErr != nil {
+				return nil, commercialErr
+			}
+			return &helix.StartCommercialResponse{Data: []helix.StartCommercialData{
+				{Length: 60, RetryAfter: 480},
+			}}, nil
+		},
+	}
+	c := &Helix{client: mock}
+	s := c.NewAdScheduler("1337", 5*time.Minute, 60)
+
+	var started int
+	s.OnCommercial = func(data *helix.StartCommercialData) {
+		started++
+	}
+
+	steps := []struct {
+		after time.Duration
+		err   error
+		want  int
+	}{
+		{4 * time.Minute, nil, 0},
+		{5 * time.Minute, nil, 1},
+		// Interval has passed, but retry_after has not.
+		{12 * time.Minute, nil, 1},
+		{13 * time.Minute, nil, 2},
+		// Failures back off for one then two poll periods.
+		{21 * time.Minute, errors.New("failure"), 3},
+		{21*time.Minute + 30*time.Second, errors.New("failure"), 3},
+		{22 * time.Minute, errors.New("failure"), 4},
+		{23 * time.Minute, nil, 4},
+		{24 * time.Minute, nil, 5},
+		// A response without a commercial backs off like a failure.
+		{32 * time.Minute, empty, 6},
+		{32*time.Minute + 30*time.Second, empty, 6},
+		{33 * time.Minute, nil, 7},
+	}
+	for _, step := range steps {
+		now = start.Add(step.after)
+		commercialErr = step.err
+		s.tick(now)
+		if len(commercials) != step.want {
+			t.Fatalf("at %v: wanted %d commercials, got %d", step.after, step.want, len(commercials))
+		}
+	}
+	if started != 4 {
+		t.Errorf("wanted OnCommercial to be called 4 times, got %d", started)
+	}
+
+	// A new stream starts a fresh interval.
+	live = false
+	s.tick(start.Add(time.Hour))
+	live = true
+	start = start.Add(2 * time.Hour)
+	now = start.Add(4 * time.Minute)
+	s.tick(now)
+	if len(commercials) != 7 {
+		t.Error("commercial started before the interval of a new stream")
+	}
+}
+
+// Tests that Stop waits for the running check so no commercial starts after it returns.
+func TestAdSchedulerStop(t *testing.T) {
+	var mu sync.Mutex
+	polls := 0
+	entered := make(chan bool, 1)
+	release := make(chan bool)
+
+	mock := &mockHelixClient{
+		getStreams: func(opt *helix.GetStreamsOpt) (*helix.GetStreamsResponse, error) {
+			mu.Lock()
+			polls++
+			mu.Unlock()
+			select {
+			case entered <- true:
+				<-release
+			default:
+			}
+			return &helix.GetStreamsResponse{}, nil
+		},
+	}
+	c := &Helix{client: mock}
+	s := c.NewAdScheduler("1337", 5*time.Minute, 60)
+	s.PollPeriod = time.Millisecond
+	s.now = func() time.Time {
+		return time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+	}
+
+	if err := s.Start(); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Start(); err == nil {
+		t.Error("expected error for starting twice")
+	}
+	<-entered
+
+	stopped := make(chan bool)
+	go func() {
+		s.Stop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+		t.Fatal("Stop returned while a check was running")
+	case <-time.After(20 * time.Millisecond):
+	}
+	close(release)
+	<-stopped
+
+	mu.Lock()
+	after := polls
+	mu.Unlock()
+	time.Sleep(10 * time.Millisecond)
+	mu.Lock()
+	defer mu.Unlock()
+	if polls != after {
+		t.Error("scheduler kept polling after Stop")
+	}
+	if err := s.Stop(); err == nil {
+		t.Error("expected error for stopping twice")
+	}
+}
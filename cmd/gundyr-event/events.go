@@ -0,0 +1,250 @@
+package main
+
+import (
+	"github.com/kelr/gundyr/eventsub"
+	"time"
+)
+
+// fakeEvent describes how to build a well-formed event for a subscription type.
+type fakeEvent struct {
+	version   string
+	condition func(broadcasterID string, userID string) map[string]interface{}
+	event     func(broadcasterID string, userID string) interface{}
+}
+
+// The user login and name used for every fake user.
+const (
+	fakeBroadcasterLogin = "testbroadcaster"
+	fakeUserLogin        = "testuser"
+	fakeRaiderID         = "1234"
+	fakeRaiderLogin      = "testraider"
+)
+
+// broadcasterCondition filters on the broadcaster only.
+func broadcasterCondition(broadcasterID string, userID string) map[string]interface{} {
+	return map[string]interface{}{"broadcaster_user_id": broadcasterID}
+}
+
+// moderatorCondition filters on the broadcaster, with the broadcaster acting as moderator.
+func moderatorCondition(broadcasterID string, userID string) map[string]interface{} {
+	return map[string]interface{}{"broadcaster_user_id": broadcasterID, "moderator_user_id": broadcasterID}
+}
+
+// fakeReward returns a channel points reward with typical settings.
+func fakeReward(broadcasterID string) *eventsub.ChannelPointsRewardEvent {
+	return &eventsub.ChannelPointsRewardEvent{
+		ID:                   "9001",
+		BroadcasterUserID:    broadcasterID,
+		BroadcasterUserLogin: fakeBroadcasterLogin,
+		BroadcasterUserName:  fakeBroadcasterLogin,
+		IsEnabled:            true,
+		IsInStock:            true,
+		Title:                "Hydrate",
+		Cost:                 500,
+		Prompt:               "Make the streamer drink water",
+		MaxPerStream:         eventsub.RewardLimit{IsEnabled: true, Value: 10},
+		MaxPerUserPerStream:  eventsub.RewardLimit{IsEnabled: true, Value: 2},
+		GlobalCooldown:       eventsub.RewardCooldown{IsEnabled: true, Seconds: 60},
+		BackgroundColor:      "#FA1ED2",
+		DefaultImage: eventsub.RewardImage{
+			URL1x: "https://static-cdn.jtvnw.net/custom-reward-images/default-1.png",
+			URL2x: "https://static-cdn.jtvnw.net/custom-reward-images/default-2.png",
+			URL4x: "https://static-cdn.jtvnw.net/custom-reward-images/default-4.png",
+		},
+	}
+}
+
+// fakeRedemption returns a pending redemption of the fake reward.
+func fakeRedemption(broadcasterID string, userID string) interface{} {
+	return &eventsub.ChannelPointsRedemptionEvent{
+		ID:                   "17fa2df1-ad76-4804-bfa5-a40ef63efe63",
+		BroadcasterUserID:    broadcasterID,
+		BroadcasterUserLogin: fakeBroadcasterLogin,
+		BroadcasterUserName:  fakeBroadcasterLogin,
+		UserID:               userID,
+		UserLogin:            fakeUserLogin,
+		UserName:             fakeUserLogin,
+		Status:               "unfulfilled",
+		Reward: eventsub.RedemptionReward{
+			ID:     "9001",
+			Title:  "Hydrate",
+			Cost:   500,
+			Prompt: "Make the streamer drink water",
+		},
+		RedeemedAt: time.Now().UTC(),
+	}
+}
+
+// fakeModerator returns a user given or removed moderator privileges.
+func fakeModerator(broadcasterID string, userID string) interface{} {
+	return &eventsub.ChannelModeratorEvent{
+		UserID:               userID,
+		UserLogin:            fakeUserLogin,
+		UserName:             fakeUserLogin,
+		BroadcasterUserID:    broadcasterID,
+		BroadcasterUserLogin: fakeBroadcasterLogin,
+		BroadcasterUserName:  fakeBroadcasterLogin,
+	}
+}
+
+// The subscription types the receiver decodes, and how to fake an event for each.
+var fakeEvents = map[string]fakeEvent{
+	"channel.follow": {"2", moderatorCondition, func(broadcasterID string, userID string) interface{} {
+		return &eventsub.ChannelFollowEvent{
+			UserID:               userID,
+			UserLogin:            fakeUserLogin,
+			UserName:             fakeUserLogin,
+			BroadcasterUserID:    broadcasterID,
+			BroadcasterUserLogin: fakeBroadcasterLogin,
+			BroadcasterUserName:  fakeBroadcasterLogin,
+			FollowedAt:           time.Now().UTC(),
+		}
+	}},
+	"channel.update": {"2", broadcasterCondition, func(broadcasterID string, userID string) interface{} {
+		return &eventsub.ChannelUpdateEvent{
+			BroadcasterUserID:           broadcasterID,
+			BroadcasterUserLogin:        fakeBroadcasterLogin,
+			BroadcasterUserName:         fakeBroadcasterLogin,
+			Title:                       "Best Stream Ever",
+			Language:                    "en",
+			CategoryID:                  "509658",
+			CategoryName:                "Just Chatting",
+			ContentClassificationLabels: []string{},
+		}
+	}},
+	"channel.raid": {"1", func(broadcasterID string, userID string) map[string]interface{} {
+		return map[string]interface{}{"to_broadcaster_user_id": broadcasterID}
+	}, func(broadcasterID string, userID string) interface{} {
+		return &eventsub.ChannelRaidEvent{
+			FromBroadcasterUserID:    fakeRaiderID,
+			FromBroadcasterUserLogin: fakeRaiderLogin,
+			FromBroadcasterUserName:  fakeRaiderLogin,
+			ToBroadcasterUserID:      broadcasterID,
+			ToBroadcasterUserLogin:   fakeBroadcasterLogin,
+			ToBroadcasterUserName:    fakeBroadcasterLogin,
+			Viewers:                  9001,
+		}
+	}},
+	"channel.ban": {"1", broadcasterCondition, func(broadcasterID string, userID string) interface{} {
+		endsAt := time.Now().UTC().Add(10 * time.Minute)
+		return &eventsub.ChannelBanEvent{
+			UserID:               userID,
+			UserLogin:            fakeUserLogin,
+			UserName:             fakeUserLogin,
+			BroadcasterUserID:    broadcasterID,
+			BroadcasterUserLogin: fakeBroadcasterLogin,
+			BroadcasterUserName:  fakeBroadcasterLogin,
+			ModeratorUserID:      broadcasterID,
+			ModeratorUserLogin:   fakeBroadcasterLogin,
+			ModeratorUserName:    fakeBroadcasterLogin,
+			Reason:               "Offensive language",
+			BannedAt:             time.Now().UTC(),
+			EndsAt:               &endsAt,
+		}
+	}},
+	"channel.unban": {"1", broadcasterCondition, func(broadcasterID string, userID string) interface{} {
+		return &eventsub.ChannelUnbanEvent{
+			UserID:               userID,
+			UserLogin:            fakeUserLogin,
+			UserName:             fakeUserLogin,
+			BroadcasterUserID:    broadcasterID,
+			BroadcasterUserLogin: fakeBroadcasterLogin,
+			BroadcasterUserName:  fakeBroadcasterLogin,
+			ModeratorUserID:      broadcasterID,
+			ModeratorUserLogin:   fakeBroadcasterLogin,
+			ModeratorUserName:    fakeBroadcasterLogin,
+		}
+	}},
+	"channel.moderator.add":    {"1", broadcasterCondition, fakeModerator},
+	"channel.moderator.remove": {"1", broadcasterCondition, fakeModerator},
+	"channel.subscribe": {"1", broadcasterCondition, func(broadcasterID string, userID string) interface{} {
+		return &eventsub.ChannelSubscribeEvent{
+			UserID:               userID,
+			UserLogin:            fakeUserLogin,
+			UserName:             fakeUserLogin,
+			BroadcasterUserID:    broadcasterID,
+			BroadcasterUserLogin: fakeBroadcasterLogin,
+			BroadcasterUserName:  fakeBroadcasterLogin,
+			Tier:                 "1000",
+		}
+	}},
+	"channel.subscription.gift": {"1", broadcasterCondition, func(broadcasterID string, userID string) interface{} {
+		total := 284
+		return &eventsub.ChannelSubscriptionGiftEvent{
+			UserID:               userID,
+			UserLogin:            fakeUserLogin,
+			UserName:             fakeUserLogin,
+			BroadcasterUserID:    broadcasterID,
+			BroadcasterUserLogin: fakeBroadcasterLogin,
+			BroadcasterUserName:  fakeBroadcasterLogin,
+			Total:                2,
+			Tier:                 "1000",
+			CumulativeTotal:      &total,
+		}
+	}},
+	"channel.subscription.message": {"1", broadcasterCondition, func(broadcasterID string, userID string) interface{} {
+		streak := 3
+		return &eventsub.ChannelSubscriptionMessageEvent{
+			UserID:               userID,
+			UserLogin:            fakeUserLogin,
+			UserName:             fakeUserLogin,
+			BroadcasterUserID:    broadcasterID,
+			BroadcasterUserLogin: fakeBroadcasterLogin,
+			BroadcasterUserName:  fakeBroadcasterLogin,
+			Tier:                 "1000",
+			Message: eventsub.SubscriptionMessage{
+				Text:   "Love the stream! FevziGG",
+				Emotes: []eventsub.SubscriptionEmote{{Begin: 17, End: 23, ID: "302976485"}},
+			},
+			CumulativeMonths: 15,
+			StreakMonths:     &streak,
+			DurationMonths:   6,
+		}
+	}},
+	"channel.cheer": {"1", broadcasterCondition, func(broadcasterID string, userID string) interface{} {
+		return &eventsub.ChannelCheerEvent{
+			UserID:               userID,
+			UserLogin:            fakeUserLogin,
+			UserName:             fakeUserLogin,
+			BroadcasterUserID:    broadcasterID,
+			BroadcasterUserLogin: fakeBroadcasterLogin,
+			BroadcasterUserName:  fakeBroadcasterLogin,
+			Message:              "pogchamp",
+			Bits:                 1000,
+		}
+	}},
+	"stream.online": {"1", broadcasterCondition, func(broadcasterID string, userID string) interface{} {
+		return &eventsub.StreamOnlineEvent{
+			ID:                   "9001",
+			BroadcasterUserID:    broadcasterID,
+			BroadcasterUserLogin: fakeBroadcasterLogin,
+			BroadcasterUserName:  fakeBroadcasterLogin,
+			Type:                 "live",
+			StartedAt:            time.Now().UTC(),
+		}
+	}},
+	"stream.offline": {"1", broadcasterCondition, func(broadcasterID string, userID string) interface{} {
+		return &eventsub.StreamOfflineEvent{
+			BroadcasterUserID:    broadcasterID,
+			BroadcasterUserLogin: fakeBroadcasterLogin,
+			BroadcasterUserName:  fakeBroadcasterLogin,
+		}
+	}},
+	"channel.channel_points_custom_reward.add": {"1", broadcasterCondition, func(broadcasterID string, userID string) interface{} {
+		return fakeReward(broadcasterID)
+	}},
+	"channel.channel_points_custom_reward.update": {"1", broadcasterCondition, func(broadcasterID string, userID string) interface{} {
+		reward := fakeReward(broadcasterID)
+		reward.Cost = 1000
+		return reward
+	}},
+	"channel.channel_points_custom_reward.remove": {"1", broadcasterCondition, func(broadcasterID string, userID string) interface{} {
+		return fakeReward(broadcasterID)
+	}},
+	"channel.channel_points_custom_reward_redemption.add": {"1", broadcasterCondition, fakeRedemption},
+	"channel.channel_points_custom_reward_redemption.update": {"1", broadcasterCondition, func(broadcasterID string, userID string) interface{} {
+		redemption := fakeRedemption(broadcasterID, userID).(*eventsub.ChannelPointsRedemptionEvent)
+		redemption.Status = "fulfilled"
+		return redemption
+	}},
+}
@@ -0,0 +1,174 @@
+// Command gundyr-event sends signed EventSub webhook messages with fake payloads to a local receiver,
+// so handlers can be developed without creating real subscriptions.
+//
+// Usage:
+//
+//	gundyr-event -secret s3cr3t-s3cr3t -type channel.follow
+//	gundyr-event -secret s3cr3t-s3cr3t -type stream.online -message webhook_callback_verification
+//	gundyr-event -list
+package main
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"github.com/kelr/gundyr/eventsub"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"sort"
+	"time"
+)
+
+// webhookMessage represents the body of a message sent to a webhook callback.
+type webhookMessage struct {
+	Challenge    string                 `json:"challenge,omitempty"`
+	Subscription *eventsub.Subscription `json:"subscription"`
+	Event        interface{}            `json:"event,omitempty"`
+}
+
+func main() {
+	url := flag.String("url", "http://localhost:8080/eventsub", "URL of the webhook receiver")
+	secret := flag.String("secret", "", "secret used to sign the message, as given when creating the subscription")
+	subscriptionType := flag.String("type", "", "subscription type of the message")
+	messageType := flag.String("message", eventsub.MessageTypeNotification, "message type: notification, webhook_callback_verification or revocation")
+	broadcasterID := flag.String("broadcaster", "1337", "broadcaster user ID used in the condition and event")
+	userID := flag.String("user", "4242", "user ID of the user acting in the event")
+	messageID := flag.String("id", "", "message ID, random if empty. Reuse an ID to send a duplicate")
+	list := flag.Bool("list", false, "list the supported subscription types and exit")
+	flag.Parse()
+
+	if *list {
+		for _, t := range supportedTypes() {
+			fmt.Println(t, "v"+fakeEvents[t].version)
+		}
+		return
+	}
+
+	fake, ok := fakeEvents[*subscriptionType]
+	if !ok {
+		fmt.Fprintln(os.Stderr, "Unsupported subscription type:", *subscriptionType)
+		fmt.Fprintln(os.Stderr, "Run with -list to see the supported types.")
+		os.Exit(2)
+	}
+	if *secret == "" {
+		fmt.Fprintln(os.Stderr, "A -secret is required to sign the message.")
+		os.Exit(2)
+	}
+	if *messageID == "" {
+		*messageID = randomID()
+	}
+
+	sub, msg, err := newMessage(fake, *subscriptionType, *messageType, *url, *broadcasterID, *userID)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+
+	body, err := json.Marshal(msg)
+	if err != nil {
+		log.Fatal(err)
+	}
+	status, response, err := send(*url, *secret, *messageID, *messageType, sub, body)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Println("Sent", *messageType, "for", sub.Type, "v"+sub.Version, "with message ID", *messageID)
+	fmt.Println("Response:", status, string(response))
+	if *messageType == eventsub.MessageTypeVerification && string(response) != msg.Challenge {
+		fmt.Println("Receiver did not respond with the challenge:", msg.Challenge)
+		os.Exit(1)
+	}
+	if status/100 != 2 {
+		os.Exit(1)
+	}
+}
+
+// newMessage builds a message of messageType for a subscription to subscriptionType delivered to url,
+// using fake to fill in the condition and event.
+func newMessage(fake fakeEvent, subscriptionType string, messageType string, url string, broadcasterID string, userID string) (*eventsub.Subscription, *webhookMessage, error) {
+	sub := &eventsub.Subscription{
+		ID:        randomID(),
+		Status:    "enabled",
+		Type:      subscriptionType,
+		Version:   fake.version,
+		Condition: fake.condition(broadcasterID, userID),
+		Transport: eventsub.Transport{
+			Method:   "webhook",
+			Callback: url,
+		},
+		CreatedAt: time.Now().UTC(),
+	}
+	msg := &webhookMessage{
+		Subscription: sub,
+	}
+	switch messageType {
+	case eventsub.MessageTypeNotification:
+		msg.Event = fake.event(broadcasterID, userID)
+	case eventsub.MessageTypeVerification:
+		sub.Status = "webhook_callback_verification_pending"
+		msg.Challenge = randomID()
+	case eventsub.MessageTypeRevocation:
+		sub.Status = "authorization_revoked"
+	default:
+		return nil, nil, errors.New("Unsupported message type: " + messageType)
+	}
+	return sub, msg, nil
+}
+
+// send POSTs a message to url, signed with secret the same way Twitch signs it.
+// Returns the response status and body.
+func send(url string, secret string, messageID string, messageType string, sub *eventsub.Subscription, body []byte) (int, []byte, error) {
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return 0, nil, err
+	}
+
+	timestamp := time.Now().UTC().Format(time.RFC3339Nano)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(eventsub.HeaderMessageID, messageID)
+	req.Header.Set(eventsub.HeaderMessageRetry, "0")
+	req.Header.Set(eventsub.HeaderMessageType, messageType)
+	req.Header.Set(eventsub.HeaderMessageTimestamp, timestamp)
+	req.Header.Set(eventsub.HeaderMessageSignature, eventsub.Sign(secret, messageID, timestamp, body))
+	req.Header.Set(eventsub.HeaderSubscriptionType, sub.Type)
+	req.Header.Set(eventsub.HeaderSubscriptionVersion, sub.Version)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return 0, nil, err
+	}
+	defer resp.Body.Close()
+
+	response, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return 0, nil, err
+	}
+	return resp.StatusCode, response, nil
+}
+
+// supportedTypes returns the subscription types that can be faked, sorted by name.
+func supportedTypes() []string {
+	types := make([]string, 0, len(fakeEvents))
+	for t := range fakeEvents {
+		types = append(types, t)
+	}
+	sort.Strings(types)
+	return types
+}
+
+// randomID returns a random ID formatted as a UUID, like the IDs used by Twitch.
+func randomID() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		log.Fatal(err)
+	}
+	h := hex.EncodeToString(b)
+	return h[0:8] + "-" + h[8:12] + "-" + h[12:16] + "-" + h[16:20] + "-" + h[20:]
+}
@@ -0,0 +1,137 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/kelr/gundyr/eventsub"
+)
+
+const testSecret = "s3cr3t-s3cr3t"
+
+// Registers the typed handler of each supported subscription type, which calls hit when it receives an event.
+var typedListeners = map[string]func(wh *eventsub.Webhook, hit func()){
+	"channel.follow": func(wh *eventsub.Webhook, hit func()) {
+		wh.ListenChannelFollow(func(*eventsub.ChannelFollowEvent) { hit() })
+	},
+	"channel.update": func(wh *eventsub.Webhook, hit func()) {
+		wh.ListenChannelUpdate(func(*eventsub.ChannelUpdateEvent) { hit() })
+	},
+	"channel.raid": func(wh *eventsub.Webhook, hit func()) {
+		wh.ListenChannelRaid(func(*eventsub.ChannelRaidEvent) { hit() })
+	},
+	"channel.ban": func(wh *eventsub.Webhook, hit func()) {
+		wh.ListenChannelBan(func(*eventsub.ChannelBanEvent) { hit() })
+	},
+	"channel.unban": func(wh *eventsub.Webhook, hit func()) {
+		wh.ListenChannelUnban(func(*eventsub.ChannelUnbanEvent) { hit() })
+	},
+	"channel.moderator.add": func(wh *eventsub.Webhook, hit func()) {
+		wh.ListenChannelModeratorAdd(func(*eventsub.ChannelModeratorEvent) { hit() })
+	},
+	"channel.moderator.remove": func(wh *eventsub.Webhook, hit func()) {
+		wh.ListenChannelModeratorRemove(func(*eventsub.ChannelModeratorEvent) { hit() })
+	},
+	"channel.subscribe": func(wh *eventsub.Webhook, hit func()) {
+		wh.ListenChannelSubscribe(func(*eventsub.ChannelSubscribeEvent) { hit() })
+	},
+	"channel.subscription.gift": func(wh *eventsub.Webhook, hit func()) {
+		wh.ListenChannelSubscriptionGift(func(*eventsub.ChannelSubscriptionGiftEvent) { hit() })
+	},
+	"channel.subscription.message": func(wh *eventsub.Webhook, hit func()) {
+		wh.ListenChannelSubscriptionMessage(func(*eventsub.ChannelSubscriptionMessageEvent) { hit() })
+	},
+	"channel.cheer": func(wh *eventsub.Webhook, hit func()) {
+		wh.ListenChannelCheer(func(*eventsub.ChannelCheerEvent) { hit() })
+	},
+	"stream.online": func(wh *eventsub.Webhook, hit func()) {
+		wh.ListenStreamOnline(func(*eventsub.StreamOnlineEvent) { hit() })
+	},
+	"stream.offline": func(wh *eventsub.Webhook, hit func()) {
+		wh.ListenStreamOffline(func(*eventsub.StreamOfflineEvent) { hit() })
+	},
+	"channel.channel_points_custom_reward.add": func(wh *eventsub.Webhook, hit func()) {
+		wh.ListenChannelPointsRewardAdd(func(*eventsub.ChannelPointsRewardEvent) { hit() })
+	},
+	"channel.channel_points_custom_reward.update": func(wh *eventsub.Webhook, hit func()) {
+		wh.ListenChannelPointsRewardUpdate(func(*eventsub.ChannelPointsRewardEvent) { hit() })
+	},
+	"channel.channel_points_custom_reward.remove": func(wh *eventsub.Webhook, hit func()) {
+		wh.ListenChannelPointsRewardRemove(func(*eventsub.ChannelPointsRewardEvent) { hit() })
+	},
+	"channel.channel_points_custom_reward_redemption.add": func(wh *eventsub.Webhook, hit func()) {
+		wh.ListenChannelPointsRedemptionAdd(func(*eventsub.ChannelPointsRedemptionEvent) { hit() })
+	},
+	"channel.channel_points_custom_reward_redemption.update": func(wh *eventsub.Webhook, hit func()) {
+		wh.ListenChannelPointsRedemptionUpdate(func(*eventsub.ChannelPointsRedemptionEvent) { hit() })
+	},
+}
+
+// Sends a signed message built from fake to the webhook at url.
+func sendFake(t *testing.T, url string, subscriptionType string, messageType string) (int, []byte, *webhookMessage) {
+	sub, msg, err := newMessage(fakeEvents[subscriptionType], subscriptionType, messageType, url, "1337", "4242")
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, err := json.Marshal(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	status, response, err := send(url, testSecret, randomID(), messageType, sub, body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return status, response, msg
+}
+
+// Tests that every fake event is accepted by an eventsub.Webhook and decoded by the typed handler for its
+// subscription type and version, so the headers, versions and payloads of the tool match the eventsub package.
+func TestFakeEventsReachTypedHandlers(t *testing.T) {
+	for _, subscriptionType := range supportedTypes() {
+		if _, ok := typedListeners[subscriptionType]; !ok {
+			t.Errorf("%s: no typed listener to test against", subscriptionType)
+		}
+	}
+
+	for _, subscriptionType := range supportedTypes() {
+		register, ok := typedListeners[subscriptionType]
+		if !ok {
+			continue
+		}
+
+		typed := make(chan bool, 1)
+		raw := make(chan bool, 1)
+		wh := eventsub.NewWebhook(testSecret)
+		register(wh, func() { typed <- true })
+		wh.ListenRaw(func(*eventsub.Subscription, json.RawMessage) { raw <- true })
+		srv := httptest.NewServer(wh)
+
+		status, response, _ := sendFake(t, srv.URL, subscriptionType, eventsub.MessageTypeNotification)
+		if status != http.StatusNoContent {
+			t.Errorf("%s: wanted: %d\n got: %d %s\n", subscriptionType, http.StatusNoContent, status, response)
+		} else {
+			select {
+			case <-typed:
+			case <-raw:
+				t.Errorf("%s v%s: event reached the raw handler", subscriptionType, fakeEvents[subscriptionType].version)
+			case <-time.After(time.Second):
+				t.Errorf("%s: event was not handled", subscriptionType)
+			}
+		}
+		srv.Close()
+	}
+}
+
+// Tests that a webhook answers a fake verification with its challenge.
+func TestFakeVerification(t *testing.T) {
+	srv := httptest.NewServer(eventsub.NewWebhook(testSecret))
+	defer srv.Close()
+
+	status, response, msg := sendFake(t, srv.URL, "stream.online", eventsub.MessageTypeVerification)
+	if status != http.StatusOK || string(response) != msg.Challenge {
+		t.Errorf("wanted challenge %s, got: %d %s", msg.Challenge, status, response)
+	}
+}
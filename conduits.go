@@ -0,0 +1,140 @@
+package gundyr
+
+import (
+	"github.com/kelr/gundyr/helix"
+)
+
+// ShardAssignment represents the changes made to a conduit's shards by BalanceConduitShards.
+type ShardAssignment struct {
+	// Assigned contains the shards that were given a new transport.
+	Assigned []helix.ConduitShard
+	// Unassigned contains the IDs of shards that need a transport but none was available.
+	Unassigned []string
+	// Failed contains the shards that Twitch refused to update.
+	Failed []helix.ConduitShardError
+}
+
+// GetConduitShards returns all of the shards of the conduit identified by conduitID.
+// Requires an app token.
+func (c *Helix) GetConduitShards(conduitID string) ([]helix.ConduitShard, error) {
+	var shards []helix.ConduitShard
+	opt := &helix.GetConduitShardsOpt{
+		ConduitID: conduitID,
+	}
+
+	response, err := c.client.GetConduitShards(opt)
+	if err != nil {
+		return nil, err
+	}
+
+	// Drain all the shards by checking each page until there are none left.
+	for len(response.Data) > 0 {
+		shards = append(shards, response.Data...)
+		if response.Pagination.Cursor == "" {
+			break
+		}
+
+		opt = &helix.GetConduitShardsOpt{
+			ConduitID: conduitID,
+			After:     response.Pagination.Cursor,
+		}
+
+		response, err = c.client.GetConduitShards(opt)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return shards, nil
+}
+
+// BalanceConduitShards spreads the shards of a conduit across transports, the WebSocket sessions or
+// webhook callbacks that are currently able to receive notifications. Shards that are disabled, such as
+// after their WebSocket session disconnected, or whose transport is not in transports are reassigned to
+// the transport with the fewest shards. A WebSocket session can serve only one shard, while a webhook
+// callback can serve any number. Healthy shards are left alone, so call this again whenever a session
+// disconnects or a new one connects.
+// Requires an app token.
+func (c *Helix) BalanceConduitShards(conduitID string, transports []helix.EventSubTransport) (*ShardAssignment, error) {
+	shards, err := c.GetConduitShards(conduitID)
+	if err != nil {
+		return nil, err
+	}
+
+	index := make(map[string]int, len(transports))
+	for i := range transports {
+		index[shardTransportKey(&transports[i])] = i
+	}
+
+	// Count the shards each transport already serves and collect the ones that need a new transport.
+	load := make([]int, len(transports))
+	var pending []string
+	for i := range shards {
+		s := &shards[i]
+		if t, ok := index[shardTransportKey(&s.Transport)]; ok && isActiveShard(s.Status) {
+			load[t]++
+			continue
+		}
+		pending = append(pending, s.ID)
+	}
+
+	assignment := new(ShardAssignment)
+	body := &helix.UpdateConduitShardsBody{
+		ConduitID: conduitID,
+	}
+	for _, id := range pending {
+		i := leastLoadedTransport(transports, load)
+		if i < 0 {
+			assignment.Unassigned = append(assignment.Unassigned, id)
+			continue
+		}
+		load[i]++
+		body.Shards = append(body.Shards, helix.ConduitShard{
+			ID: id,
+			Transport: helix.EventSubTransport{
+				Method:    transports[i].Method,
+				Callback:  transports[i].Callback,
+				Secret:    transports[i].Secret,
+				SessionID: transports[i].SessionID,
+			},
+		})
+	}
+
+	if len(body.Shards) == 0 {
+		return assignment, nil
+	}
+	response, err := c.client.UpdateConduitShards(body)
+	if err != nil {
+		return assignment, err
+	}
+	assignment.Assigned = response.Data
+	assignment.Failed = response.Errors
+	return assignment, nil
+}
+
+// leastLoadedTransport returns the index of the transport serving the fewest shards that can take
+// another, or -1 if there is none. WebSocket sessions can only take a shard if they have none.
+func leastLoadedTransport(transports []helix.EventSubTransport, load []int) int {
+	best := -1
+	for i := range transports {
+		if transports[i].Method == "websocket" && load[i] > 0 {
+			continue
+		}
+		if best < 0 || load[i] < load[best] {
+			best = i
+		}
+	}
+	return best
+}
+
+// isActiveShard returns true if a shard with status is delivering, or about to deliver, notifications.
+func isActiveShard(status string) bool {
+	return status == "enabled" || status == "webhook_callback_verification_pending"
+}
+
+// shardTransportKey returns a string identifying the destination of a transport.
+func shardTransportKey(transport *helix.EventSubTransport) string {
+	if transport.Method == "websocket" {
+		return "websocket|" + transport.SessionID
+	}
+	return transport.Method + "|" + transport.Callback
+}
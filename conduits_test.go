@@ -0,0 +1,174 @@
+package gundyr
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/kelr/gundyr/helix"
+)
+
+var (
+	testSessionA = helix.EventSubTransport{Method: "websocket", SessionID: "session-a"}
+	testSessionB = helix.EventSubTransport{Method: "websocket", SessionID: "session-b"}
+	testSessionC = helix.EventSubTransport{Method: "websocket", SessionID: "session-c"}
+	testWebhook1 = helix.EventSubTransport{Method: "webhook", Callback: "https://example.com/1", Secret: "s3cr3t-s3cr3t"}
+	testWebhook2 = helix.EventSubTransport{Method: "webhook", Callback: "https://example.com/2", Secret: "s3cr3t-s3cr3t"}
+)
+
+// Tests that leastLoadedTransport picks the transport with the fewest shards, never giving a
+// WebSocket session a second shard.
+func TestLeastLoadedTransport(t *testing.T) {
+	tests := []struct {
+		name       string
+		transports []helix.EventSubTransport
+		load       []int
+		want       int
+	}{
+		{"none", nil, nil, -1},
+		{"empty session", []helix.EventSubTransport{testSessionA}, []int{0}, 0},
+		{"full session", []helix.EventSubTransport{testSessionA}, []int{1}, -1},
+		{"second session", []helix.EventSubTransport{testSessionA, testSessionB}, []int{1, 0}, 1},
+		{"least loaded webhook", []helix.EventSubTransport{testWebhook1, testWebhook2}, []int{3, 2}, 1},
+		{"first of equal webhooks", []helix.EventSubTransport{testWebhook1, testWebhook2}, []int{2, 2}, 0},
+		{"webhook after full session", []helix.EventSubTransport{testSessionA, testWebhook1}, []int{1, 5}, 1},
+		{"empty session before webhook", []helix.EventSubTransport{testWebhook1, testSessionA}, []int{1, 0}, 1},
+	}
+	for _, tc := range tests {
+		if got := leastLoadedTransport(tc.transports, tc.load); got != tc.want {
+			t.Errorf("%s: wanted: %d\n got: %d\n", tc.name, tc.want, got)
+		}
+	}
+}
+
+// Tests that BalanceConduitShards only reassigns shards without a healthy transport, spreads them across
+// the transports and reports the shards that could not be assigned or updated.
+func TestBalanceConduitShards(t *testing.T) {
+	tests := []struct {
+		name       string
+		shards     []helix.ConduitShard
+		transports []helix.EventSubTransport
+		rejected   map[string]bool
+		assigned   map[string]string
+		unassigned []string
+		failed     []string
+	}{
+		{
+			name: "one shard per websocket session",
+			shards: []helix.ConduitShard{
+				{ID: "0", Status: "websocket_disconnected"},
+				{ID: "1", Status: "websocket_disconnected"},
+				{ID: "2", Status: "websocket_disconnected"},
+			},
+			transports: []helix.EventSubTransport{testSessionA, testSessionB},
+			assigned:   map[string]string{"0": "session-a", "1": "session-b"},
+			unassigned: []string{"2"},
+		},
+		{
+			name: "least loaded webhook",
+			shards: []helix.ConduitShard{
+				{ID: "0", Status: "enabled", Transport: testWebhook1},
+				{ID: "1", Status: "enabled", Transport: testWebhook1},
+				{ID: "2"},
+				{ID: "3"},
+				{ID: "4"},
+			},
+			transports: []helix.EventSubTransport{testWebhook1, testWebhook2},
+			assigned:   map[string]string{"2": "https://example.com/2", "3": "https://example.com/2", "4": "https://example.com/1"},
+		},
+		{
+			name: "reassign disconnected websocket",
+			shards: []helix.ConduitShard{
+				{ID: "0", Status: "websocket_disconnected", Transport: testSessionA},
+				{ID: "1", Status: "enabled", Transport: testSessionC},
+			},
+			transports: []helix.EventSubTransport{testSessionC, testSessionB},
+			assigned:   map[string]string{"0": "session-b"},
+		},
+		{
+			name: "transport no longer available",
+			shards: []helix.ConduitShard{
+				{ID: "0", Status: "enabled", Transport: testWebhook1},
+			},
+			transports: []helix.EventSubTransport{testSessionB},
+			assigned:   map[string]string{"0": "session-b"},
+		},
+		{
+			name: "unassigned and failed",
+			shards: []helix.ConduitShard{
+				{ID: "0", Status: "websocket_disconnected"},
+				{ID: "1", Status: "websocket_disconnected"},
+				{ID: "2", Status: "websocket_disconnected"},
+			},
+			transports: []helix.EventSubTransport{testSessionA, testSessionB},
+			rejected:   map[string]bool{"1": true},
+			assigned:   map[string]string{"0": "session-a"},
+			unassigned: []string{"2"},
+			failed:     []string{"1"},
+		},
+		{
+			name: "healthy",
+			shards: []helix.ConduitShard{
+				{ID: "0", Status: "enabled", Transport: testSessionA},
+				{ID: "1", Status: "webhook_callback_verification_pending", Transport: testWebhook1},
+			},
+			transports: []helix.EventSubTransport{testSessionA, testWebhook1},
+			assigned:   map[string]string{},
+		},
+	}
+
+	for _, tc := range tests {
+		updates := 0
+		mock := &mockHelixClient{
+			getConduitShards: func(opt *helix.GetConduitShardsOpt) (*helix.GetConduitShardsResponse, error) {
+				if opt.ConduitID != "conduit" {
+					t.Error("unexpected conduit:", opt.ConduitID)
+				}
+				return &helix.GetConduitShardsResponse{Data: tc.shards}, nil
+			},
+			updateConduitShards: func(body *helix.UpdateConduitShardsBody) (*helix.UpdateConduitShardsResponse, error) {
+				updates++
+				resp := &helix.UpdateConduitShardsResponse{}
+				for _, s := range body.Shards {
+					if tc.rejected[s.ID] {
+						resp.Errors = append(resp.Errors, helix.ConduitShardError{ID: s.ID, Message: "rejected"})
+						continue
+					}
+					s.Status = "enabled"
+					resp.Data = append(resp.Data, s)
+				}
+				return resp, nil
+			},
+		}
+		c := &Helix{client: mock}
+
+		assignment, err := c.BalanceConduitShards("conduit", tc.transports)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assigned := make(map[string]string)
+		for _, s := range assignment.Assigned {
+			if s.Transport.Method == "websocket" {
+				assigned[s.ID] = s.Transport.SessionID
+			} else {
+				assigned[s.ID] = s.Transport.Callback
+			}
+		}
+		if !reflect.DeepEqual(assigned, tc.assigned) {
+			t.Errorf("%s: wanted assigned: %v\n got: %v\n", tc.name, tc.assigned, assigned)
+		}
+		if !reflect.DeepEqual(assignment.Unassigned, tc.unassigned) {
+			t.Errorf("%s: wanted unassigned: %v\n got: %v\n", tc.name, tc.unassigned, assignment.Unassigned)
+		}
+		var failed []string
+		for _, f := range assignment.Failed {
+			failed = append(failed, f.ID)
+		}
+		if !reflect.DeepEqual(failed, tc.failed) {
+			t.Errorf("%s: wanted failed: %v\n got: %v\n", tc.name, tc.failed, failed)
+		}
+		if wantUpdate := len(tc.assigned) > 0 || len(tc.failed) > 0; (updates == 1) != wantUpdate {
+			t.Errorf("%s: got %d updates", tc.name, updates)
+		}
+	}
+}
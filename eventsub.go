@@ -0,0 +1,143 @@
+package gundyr
+
+import (
+	"github.com/kelr/gundyr/helix"
+)
+
+// EventSubDiff represents the changes made to the client's EventSub subscriptions by ReconcileEventSub.
+// TotalCost is the total cost of the client's subscriptions after the changes, and MaxTotalCost is
+// the limit on it, as reported by Twitch.
+type EventSubDiff struct {
+	Created      []helix.EventSubSubscription
+	Deleted      []helix.EventSubSubscription
+	TotalCost    int
+	MaxTotalCost int
+}
+
+// GetEventSubSubscriptions returns all of the EventSub subscriptions created by the client.
+func (c *Helix) GetEventSubSubscriptions() ([]helix.EventSubSubscription, error) {
+	subscriptions, _, err := c.getEventSubSubscriptions()
+	return subscriptions, err
+}
+
+// getEventSubSubscriptions returns all of the EventSub subscriptions created by the client
+// and the first page of the response, which holds the total cost of the subscriptions.
+func (c *Helix) getEventSubSubscriptions() ([]helix.EventSubSubscription, *helix.EventSubSubscriptionsResponse, error) {
+	var subscriptions []helix.EventSubSubscription
+	opt := &helix.GetEventSubSubscriptionsOpt{}
+
+	first, err := c.client.GetEventSubSubscriptions(opt)
+	if err != nil {
+		return nil, nil, err
+	}
+	response := first
+
+	// Drain all the subscriptions by checking each page until there are none left.
+	for len(response.Data) > 0 {
+		subscriptions = append(subscriptions, response.Data...)
+		if response.Pagination.Cursor == "" {
+			break
+		}
+
+		opt = &helix.GetEventSubSubscriptionsOpt{
+			After: response.Pagination.Cursor,
+		}
+
+		response, err = c.client.GetEventSubSubscriptions(opt)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	return subscriptions, first, nil
+}
+
+// ReconcileEventSub makes the client's EventSub subscriptions match desired.
+// Subscriptions that are not desired, or that Twitch has disabled or revoked, are deleted, then
+// any desired subscriptions that are missing are created. Subscriptions match on their type, version,
+// condition and transport. Returns the subscriptions created and deleted, including those changed
+// before an error occurred, and the total cost of the subscriptions afterwards.
+func (c *Helix) ReconcileEventSub(desired []helix.CreateEventSubSubscriptionBody) (*EventSubDiff, error) {
+	current, first, err := c.getEventSubSubscriptions()
+	if err != nil {
+		return nil, err
+	}
+
+	isDesired := make(map[subscriptionKey]bool, len(desired))
+	for i := range desired {
+		isDesired[eventSubKey(desired[i].Type, desired[i].Version, &desired[i].Condition, &desired[i].Transport)] = true
+	}
+
+	diff := &EventSubDiff{
+		TotalCost:    first.TotalCost,
+		MaxTotalCost: first.MaxTotalCost,
+	}
+	exists := make(map[subscriptionKey]bool, len(current))
+	for i := range current {
+		s := &current[i]
+		key := eventSubKey(s.Type, s.Version, &s.Condition, &s.Transport)
+		if isDesired[key] && isActiveEventSub(s.Status) {
+			exists[key] = true
+			continue
+		}
+
+		err := c.client.DeleteEventSubSubscription(&helix.DeleteEventSubSubscriptionOpt{
+			ID: s.ID,
+		})
+		if err != nil {
+			return diff, err
+		}
+		diff.Deleted = append(diff.Deleted, *s)
+		// Subscriptions that Twitch disabled or revoked no longer count toward the total cost.
+		if isActiveEventSub(s.Status) {
+			diff.TotalCost -= s.Cost
+		}
+	}
+
+	for i := range desired {
+		key := eventSubKey(desired[i].Type, desired[i].Version, &desired[i].Condition, &desired[i].Transport)
+		if exists[key] {
+			continue
+		}
+
+		response, err := c.client.CreateEventSubSubscription(&desired[i])
+		if err != nil {
+			return diff, err
+		}
+		exists[key] = true
+		diff.Created = append(diff.Created, response.Data...)
+		// Twitch reports the total cost of every subscription after each one is created.
+		diff.TotalCost = response.TotalCost
+		diff.MaxTotalCost = response.MaxTotalCost
+	}
+	return diff, nil
+}
+
+// isActiveEventSub returns true if a subscription with status may still deliver notifications.
+func isActiveEventSub(status string) bool {
+	return status == "enabled" || status == "webhook_callback_verification_pending"
+}
+
+// subscriptionKey identifies a subscription by its type, version, condition and transport.
+type subscriptionKey struct {
+	Type      string
+	Version   string
+	Condition helix.EventSubCondition
+	Method    string
+	Callback  string
+	SessionID string
+	ConduitID string
+}
+
+// eventSubKey returns the key identifying a subscription. The transport secret is not part of the key
+// since it is never returned by the API.
+func eventSubKey(subscriptionType string, version string, condition *helix.EventSubCondition, transport *helix.EventSubTransport) subscriptionKey {
+	return subscriptionKey{
+		Type:      subscriptionType,
+		Version:   version,
+		Condition: *condition,
+		Method:    transport.Method,
+		Callback:  transport.Callback,
+		SessionID: transport.SessionID,
+		ConduitID: transport.ConduitID,
+	}
+}
@@ -0,0 +1,31 @@
+package eventsub
+
+const (
+	channelCheerType    = "channel.cheer"
+	channelCheerVersion = "1"
+)
+
+// ChannelCheerEvent contains information about bits cheered in a channel.
+// The user fields are empty if the cheer was anonymous.
+type ChannelCheerEvent struct {
+	IsAnonymous          bool   `json:"is_anonymous"`
+	UserID               string `json:"user_id"`
+	UserLogin            string `json:"user_login"`
+	UserName             string `json:"user_name"`
+	BroadcasterUserID    string `json:"broadcaster_user_id"`
+	BroadcasterUserLogin string `json:"broadcaster_user_login"`
+	BroadcasterUserName  string `json:"broadcaster_user_name"`
+	Message              string `json:"message"`
+	Bits                 int    `json:"bits"`
+}
+
+// ListenChannelCheer registers a handler function for version 1 of the channel.cheer subscription type.
+// The handler will be called with a populated ChannelCheerEvent when the event is received.
+func (d *Dispatcher) ListenChannelCheer(handler func(*ChannelCheerEvent)) {
+	d.listen(channelCheerType, channelCheerVersion, func() interface{} { return new(ChannelCheerEvent) }, func(e interface{}) { handler(e.(*ChannelCheerEvent)) })
+}
+
+// UnlistenChannelCheer removes the current handler function for the channel.cheer subscription type.
+func (d *Dispatcher) UnlistenChannelCheer() {
+	d.listen(channelCheerType, channelCheerVersion, nil, nil)
+}
@@ -0,0 +1,174 @@
+package eventsub
+
+import (
+	"time"
+)
+
+const (
+	channelFollowType             = "channel.follow"
+	channelFollowVersion          = "2"
+	channelUpdateType             = "channel.update"
+	channelUpdateVersion          = "2"
+	channelRaidType               = "channel.raid"
+	channelRaidVersion            = "1"
+	channelBanType                = "channel.ban"
+	channelBanVersion             = "1"
+	channelUnbanType              = "channel.unban"
+	channelUnbanVersion           = "1"
+	channelModeratorAddType       = "channel.moderator.add"
+	channelModeratorAddVersion    = "1"
+	channelModeratorRemoveType    = "channel.moderator.remove"
+	channelModeratorRemoveVersion = "1"
+)
+
+// ChannelFollowEvent contains information about a user who followed a channel.
+type ChannelFollowEvent struct {
+	UserID               string    `json:"user_id"`
+	UserLogin            string    `json:"user_login"`
+	UserName             string    `json:"user_name"`
+	BroadcasterUserID    string    `json:"broadcaster_user_id"`
+	BroadcasterUserLogin string    `json:"broadcaster_user_login"`
+	BroadcasterUserName  string    `json:"broadcaster_user_name"`
+	FollowedAt           time.Time `json:"followed_at"`
+}
+
+// ChannelUpdateEvent contains the updated information of a channel.
+type ChannelUpdateEvent struct {
+	BroadcasterUserID           string   `json:"broadcaster_user_id"`
+	BroadcasterUserLogin        string   `json:"broadcaster_user_login"`
+	BroadcasterUserName         string   `json:"broadcaster_user_name"`
+	Title                       string   `json:"title"`
+	Language                    string   `json:"language"`
+	CategoryID                  string   `json:"category_id"`
+	CategoryName                string   `json:"category_name"`
+	ContentClassificationLabels []string `json:"content_classification_labels"`
+}
+
+// ChannelRaidEvent contains information about a broadcaster raiding another broadcaster.
+type ChannelRaidEvent struct {
+	FromBroadcasterUserID    string `json:"from_broadcaster_user_id"`
+	FromBroadcasterUserLogin string `json:"from_broadcaster_user_login"`
+	FromBroadcasterUserName  string `json:"from_broadcaster_user_name"`
+	ToBroadcasterUserID      string `json:"to_broadcaster_user_id"`
+	ToBroadcasterUserLogin   string `json:"to_broadcaster_user_login"`
+	ToBroadcasterUserName    string `json:"to_broadcaster_user_name"`
+	Viewers                  int    `json:"viewers"`
+}
+
+// ChannelBanEvent contains information about a user banned or timed out in a channel.
+// EndsAt is nil if the ban is permanent.
+type ChannelBanEvent struct {
+	UserID               string     `json:"user_id"`
+	UserLogin            string     `json:"user_login"`
+	UserName             string     `json:"user_name"`
+	BroadcasterUserID    string     `json:"broadcaster_user_id"`
+	BroadcasterUserLogin string     `json:"broadcaster_user_login"`
+	BroadcasterUserName  string     `json:"broadcaster_user_name"`
+	ModeratorUserID      string     `json:"moderator_user_id"`
+	ModeratorUserLogin   string     `json:"moderator_user_login"`
+	ModeratorUserName    string     `json:"moderator_user_name"`
+	Reason               string     `json:"reason"`
+	BannedAt             time.Time  `json:"banned_at"`
+	EndsAt               *time.Time `json:"ends_at"`
+	IsPermanent          bool       `json:"is_permanent"`
+}
+
+// ChannelUnbanEvent contains information about a user unbanned from a channel.
+type ChannelUnbanEvent struct {
+	UserID               string `json:"user_id"`
+	UserLogin            string `json:"user_login"`
+	UserName             string `json:"user_name"`
+	BroadcasterUserID    string `json:"broadcaster_user_id"`
+	BroadcasterUserLogin string `json:"broadcaster_user_login"`
+	BroadcasterUserName  string `json:"broadcaster_user_name"`
+	ModeratorUserID      string `json:"moderator_user_id"`
+	ModeratorUserLogin   string `json:"moderator_user_login"`
+	ModeratorUserName    string `json:"moderator_user_name"`
+}
+
+// ChannelModeratorEvent contains information about a user given or removed moderator privileges in a channel.
+type ChannelModeratorEvent struct {
+	UserID               string `json:"user_id"`
+	UserLogin            string `json:"user_login"`
+	UserName             string `json:"user_name"`
+	BroadcasterUserID    string `json:"broadcaster_user_id"`
+	BroadcasterUserLogin string `json:"broadcaster_user_login"`
+	BroadcasterUserName  string `json:"broadcaster_user_name"`
+}
+
+// ListenChannelFollow registers a handler function for version 2 of the channel.follow subscription type.
+// The handler will be called with a populated ChannelFollowEvent when the event is received.
+func (d *Dispatcher) ListenChannelFollow(handler func(*ChannelFollowEvent)) {
+	d.listen(channelFollowType, channelFollowVersion, func() interface{} { return new(ChannelFollowEvent) }, func(e interface{}) { handler(e.(*ChannelFollowEvent)) })
+}
+
+// UnlistenChannelFollow removes the current handler function for the channel.follow subscription type.
+func (d *Dispatcher) UnlistenChannelFollow() {
+	d.listen(channelFollowType, channelFollowVersion, nil, nil)
+}
+
+// ListenChannelUpdate registers a handler function for version 2 of the channel.update subscription type.
+// The handler will be called with a populated ChannelUpdateEvent when the event is received.
+func (d *Dispatcher) ListenChannelUpdate(handler func(*ChannelUpdateEvent)) {
+	d.listen(channelUpdateType, channelUpdateVersion, func() interface{} { return new(ChannelUpdateEvent) }, func(e interface{}) { handler(e.(*ChannelUpdateEvent)) })
+}
+
+// UnlistenChannelUpdate removes the current handler function for the channel.update subscription type.
+func (d *Dispatcher) UnlistenChannelUpdate() {
+	d.listen(channelUpdateType, channelUpdateVersion, nil, nil)
+}
+
+// ListenChannelRaid registers a handler function for version 1 of the channel.raid subscription type.
+// The handler will be called with a populated ChannelRaidEvent when the event is received.
+func (d *Dispatcher) ListenChannelRaid(handler func(*ChannelRaidEvent)) {
+	d.listen(channelRaidType, channelRaidVersion, func() interface{} { return new(ChannelRaidEvent) }, func(e interface{}) { handler(e.(*ChannelRaidEvent)) })
+}
+
+// UnlistenChannelRaid removes the current handler function for the channel.raid subscription type.
+func (d *Dispatcher) UnlistenChannelRaid() {
+	d.listen(channelRaidType, channelRaidVersion, nil, nil)
+}
+
+// ListenChannelBan registers a handler function for version 1 of the channel.ban subscription type.
+// The handler will be called with a populated ChannelBanEvent when the event is received.
+func (d *Dispatcher) ListenChannelBan(handler func(*ChannelBanEvent)) {
+	d.listen(channelBanType, channelBanVersion, func() interface{} { return new(ChannelBanEvent) }, func(e interface{}) { handler(e.(*ChannelBanEvent)) })
+}
+
+// UnlistenChannelBan removes the current handler function for the channel.ban subscription type.
+func (d *Dispatcher) UnlistenChannelBan() {
+	d.listen(channelBanType, channelBanVersion, nil, nil)
+}
+
+// ListenChannelUnban registers a handler function for version 1 of the channel.unban subscription type.
+// The handler will be called with a populated ChannelUnbanEvent when the event is received.
+func (d *Dispatcher) ListenChannelUnban(handler func(*ChannelUnbanEvent)) {
+	d.listen(channelUnbanType, channelUnbanVersion, func() interface{} { return new(ChannelUnbanEvent) }, func(e interface{}) { handler(e.(*ChannelUnbanEvent)) })
+}
+
+// UnlistenChannelUnban removes the current handler function for the channel.unban subscription type.
+func (d *Dispatcher) UnlistenChannelUnban() {
+	d.listen(channelUnbanType, channelUnbanVersion, nil, nil)
+}
+
+// ListenChannelModeratorAdd registers a handler function for version 1 of the channel.moderator.add subscription type.
+// The handler will be called with a populated ChannelModeratorEvent when the event is received.
+func (d *Dispatcher) ListenChannelModeratorAdd(handler func(*ChannelModeratorEvent)) {
+	d.listen(channelModeratorAddType, channelModeratorAddVersion, func() interface{} { return new(ChannelModeratorEvent) }, func(e interface{}) { handler(e.(*ChannelModeratorEvent)) })
+}
+
+// UnlistenChannelModeratorAdd removes the current handler function for the channel.moderator.add subscription type.
+func (d *Dispatcher) UnlistenChannelModeratorAdd() {
+	d.listen(channelModeratorAddType, channelModeratorAddVersion, nil, nil)
+}
+
+// ListenChannelModeratorRemove registers a handler function for version 1 of the channel.moderator.remove subscription type.
+// The handler will be called with a populated ChannelModeratorEvent when the event is received.
+func (d *Dispatcher) ListenChannelModeratorRemove(handler func(*ChannelModeratorEvent)) {
+	d.listen(channelModeratorRemoveType, channelModeratorRemoveVersion, func() interface{} { return new(ChannelModeratorEvent) }, func(e interface{}) { handler(e.(*ChannelModeratorEvent)) })
+}
+
+// UnlistenChannelModeratorRemove removes the current handler function for the channel.moderator.remove subscription type.
+func (d *Dispatcher) UnlistenChannelModeratorRemove() {
+	d.listen(channelModeratorRemoveType, channelModeratorRemoveVersion, nil, nil)
+}
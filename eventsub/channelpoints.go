@@ -0,0 +1,141 @@
+package eventsub
+
+import (
+	"time"
+)
+
+const (
+	rewardAddType           = "channel.channel_points_custom_reward.add"
+	rewardAddVersion        = "1"
+	rewardUpdateType        = "channel.channel_points_custom_reward.update"
+	rewardUpdateVersion     = "1"
+	rewardRemoveType        = "channel.channel_points_custom_reward.remove"
+	rewardRemoveVersion     = "1"
+	redemptionAddType       = "channel.channel_points_custom_reward_redemption.add"
+	redemptionAddVersion    = "1"
+	redemptionUpdateType    = "channel.channel_points_custom_reward_redemption.update"
+	redemptionUpdateVersion = "1"
+)
+
+// ChannelPointsRewardEvent contains information about a custom channel points reward that was added, updated or removed.
+// CooldownExpiresAt and RedemptionsRedeemedCurrentStream are nil if there is no active cooldown or stream.
+type ChannelPointsRewardEvent struct {
+	ID                                string         `json:"id"`
+	BroadcasterUserID                 string         `json:"broadcaster_user_id"`
+	BroadcasterUserLogin              string         `json:"broadcaster_user_login"`
+	BroadcasterUserName               string         `json:"broadcaster_user_name"`
+	IsEnabled                         bool           `json:"is_enabled"`
+	IsPaused                          bool           `json:"is_paused"`
+	IsInStock                         bool           `json:"is_in_stock"`
+	Title                             string         `json:"title"`
+	Cost                              int            `json:"cost"`
+	Prompt                            string         `json:"prompt"`
+	IsUserInputRequired               bool           `json:"is_user_input_required"`
+	ShouldRedemptionsSkipRequestQueue bool           `json:"should_redemptions_skip_request_queue"`
+	CooldownExpiresAt                 *time.Time     `json:"cooldown_expires_at"`
+	RedemptionsRedeemedCurrentStream  *int           `json:"redemptions_redeemed_current_stream"`
+	MaxPerStream                      RewardLimit    `json:"max_per_stream"`
+	MaxPerUserPerStream               RewardLimit    `json:"max_per_user_per_stream"`
+	GlobalCooldown                    RewardCooldown `json:"global_cooldown"`
+	BackgroundColor                   string         `json:"background_color"`
+	Image                             *RewardImage   `json:"image"`
+	DefaultImage                      RewardImage    `json:"default_image"`
+}
+
+// RewardLimit represents a limit on the number of times a reward can be redeemed.
+type RewardLimit struct {
+	IsEnabled bool `json:"is_enabled"`
+	Value     int  `json:"value"`
+}
+
+// RewardCooldown represents the cooldown between redemptions of a reward.
+type RewardCooldown struct {
+	IsEnabled bool `json:"is_enabled"`
+	Seconds   int  `json:"seconds"`
+}
+
+// RewardImage contains the URLs of a reward image at each scale.
+type RewardImage struct {
+	URL1x string `json:"url_1x"`
+	URL2x string `json:"url_2x"`
+	URL4x string `json:"url_4x"`
+}
+
+// ChannelPointsRedemptionEvent contains information about a redemption of a custom channel points reward.
+// Status is one of unknown, unfulfilled, fulfilled or canceled.
+type ChannelPointsRedemptionEvent struct {
+	ID                   string           `json:"id"`
+	BroadcasterUserID    string           `json:"broadcaster_user_id"`
+	BroadcasterUserLogin string           `json:"broadcaster_user_login"`
+	BroadcasterUserName  string           `json:"broadcaster_user_name"`
+	UserID               string           `json:"user_id"`
+	UserLogin            string           `json:"user_login"`
+	UserName             string           `json:"user_name"`
+	UserInput            string           `json:"user_input"`
+	Status               string           `json:"status"`
+	Reward               RedemptionReward `json:"reward"`
+	RedeemedAt           time.Time        `json:"redeemed_at"`
+}
+
+// RedemptionReward contains basic information about the reward that was redeemed.
+type RedemptionReward struct {
+	ID     string `json:"id"`
+	Title  string `json:"title"`
+	Cost   int    `json:"cost"`
+	Prompt string `json:"prompt"`
+}
+
+// ListenChannelPointsRewardAdd registers a handler function for version 1 of the channel.channel_points_custom_reward.add subscription type.
+// The handler will be called with a populated ChannelPointsRewardEvent when the event is received.
+func (d *Dispatcher) ListenChannelPointsRewardAdd(handler func(*ChannelPointsRewardEvent)) {
+	d.listen(rewardAddType, rewardAddVersion, func() interface{} { return new(ChannelPointsRewardEvent) }, func(e interface{}) { handler(e.(*ChannelPointsRewardEvent)) })
+}
+
+// UnlistenChannelPointsRewardAdd removes the current handler function for the channel.channel_points_custom_reward.add subscription type.
+func (d *Dispatcher) UnlistenChannelPointsRewardAdd() {
+	d.listen(rewardAddType, rewardAddVersion, nil, nil)
+}
+
+// ListenChannelPointsRewardUpdate registers a handler function for version 1 of the channel.channel_points_custom_reward.update subscription type.
+// The handler will be called with a populated ChannelPointsRewardEvent when the event is received.
+func (d *Dispatcher) ListenChannelPointsRewardUpdate(handler func(*ChannelPointsRewardEvent)) {
+	d.listen(rewardUpdateType, rewardUpdateVersion, func() interface{} { return new(ChannelPointsRewardEvent) }, func(e interface{}) { handler(e.(*ChannelPointsRewardEvent)) })
+}
+
+// UnlistenChannelPointsRewardUpdate removes the current handler function for the channel.channel_points_custom_reward.update subscription type.
+func (d *Dispatcher) UnlistenChannelPointsRewardUpdate() {
+	d.listen(rewardUpdateType, rewardUpdateVersion, nil, nil)
+}
+
+// ListenChannelPointsRewardRemove registers a handler function for version 1 of the channel.channel_points_custom_reward.remove subscription type.
+// The handler will be called with a populated ChannelPointsRewardEvent when the event is received.
+func (d *Dispatcher) ListenChannelPointsRewardRemove(handler func(*ChannelPointsRewardEvent)) {
+	d.listen(rewardRemoveType, rewardRemoveVersion, func() interface{} { return new(ChannelPointsRewardEvent) }, func(e interface{}) { handler(e.(*ChannelPointsRewardEvent)) })
+}
+
+// UnlistenChannelPointsRewardRemove removes the current handler function for the channel.channel_points_custom_reward.remove subscription type.
+func (d *Dispatcher) UnlistenChannelPointsRewardRemove() {
+	d.listen(rewardRemoveType, rewardRemoveVersion, nil, nil)
+}
+
+// ListenChannelPointsRedemptionAdd registers a handler function for version 1 of the channel.channel_points_custom_reward_redemption.add subscription type.
+// The handler will be called with a populated ChannelPointsRedemptionEvent when the event is received.
+func (d *Dispatcher) ListenChannelPointsRedemptionAdd(handler func(*ChannelPointsRedemptionEvent)) {
+	d.listen(redemptionAddType, redemptionAddVersion, func() interface{} { return new(ChannelPointsRedemptionEvent) }, func(e interface{}) { handler(e.(*ChannelPointsRedemptionEvent)) })
+}
+
+// UnlistenChannelPointsRedemptionAdd removes the current handler function for the channel.channel_points_custom_reward_redemption.add subscription type.
+func (d *Dispatcher) UnlistenChannelPointsRedemptionAdd() {
+	d.listen(redemptionAddType, redemptionAddVersion, nil, nil)
+}
+
+// ListenChannelPointsRedemptionUpdate registers a handler function for version 1 of the channel.channel_points_custom_reward_redemption.update subscription type.
+// The handler will be called with a populated ChannelPointsRedemptionEvent when the event is received.
+func (d *Dispatcher) ListenChannelPointsRedemptionUpdate(handler func(*ChannelPointsRedemptionEvent)) {
+	d.listen(redemptionUpdateType, redemptionUpdateVersion, func() interface{} { return new(ChannelPointsRedemptionEvent) }, func(e interface{}) { handler(e.(*ChannelPointsRedemptionEvent)) })
+}
+
+// UnlistenChannelPointsRedemptionUpdate removes the current handler function for the channel.channel_points_custom_reward_redemption.update subscription type.
+func (d *Dispatcher) UnlistenChannelPointsRedemptionUpdate() {
+	d.listen(redemptionUpdateType, redemptionUpdateVersion, nil, nil)
+}
@@ -0,0 +1,177 @@
+// Package eventsub provides receivers for Twitch EventSub notifications.
+package eventsub
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Subscription represents the EventSub subscription that a message was sent for.
+// Condition holds the condition fields of the subscription type, which are not all strings.
+type Subscription struct {
+	ID        string                 `json:"id"`
+	Status    string                 `json:"status"`
+	Type      string                 `json:"type"`
+	Version   string                 `json:"version"`
+	Cost      int                    `json:"cost"`
+	Condition map[string]interface{} `json:"condition"`
+	Transport Transport              `json:"transport"`
+	CreatedAt time.Time              `json:"created_at"`
+}
+
+// Transport represents how the notifications of a subscription are delivered.
+type Transport struct {
+	Method    string `json:"method"`
+	Callback  string `json:"callback,omitempty"`
+	SessionID string `json:"session_id,omitempty"`
+}
+
+// handlerKey identifies the decoder for a version of a subscription type, since payloads change between versions.
+type handlerKey struct {
+	subscriptionType string
+	version          string
+}
+
+// Dispatcher routes EventSub notifications to the handler registered for their subscription type and version.
+// It is embedded by each transport so handlers are registered the same way regardless of how events arrive.
+type Dispatcher struct {
+	duplicates        uint64
+	mu                *sync.Mutex
+	handlers          map[handlerKey]func(*Subscription, json.RawMessage) error
+	rawHandler        func(*Subscription, json.RawMessage)
+	revocationHandler func(*Subscription)
+	store             MessageIDStore
+}
+
+// NewDispatcher returns a Dispatcher with no handlers registered.
+// Duplicate messages are detected with a MemoryStore using the default capacity and TTL.
+func NewDispatcher() *Dispatcher {
+	return &Dispatcher{
+		mu:       &sync.Mutex{},
+		handlers: make(map[handlerKey]func(*Subscription, json.RawMessage) error),
+		store:    newMemoryStore(DefaultMessageIDCapacity, DefaultMessageIDTTL),
+	}
+}
+
+// SetMessageIDStore sets the store used to detect duplicate messages.
+// A nil store disables duplicate detection.
+func (d *Dispatcher) SetMessageIDStore(store MessageIDStore) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.store = store
+}
+
+// Duplicates returns the number of duplicate messages that were acknowledged without being dispatched.
+func (d *Dispatcher) Duplicates() uint64 {
+	return atomic.LoadUint64(&d.duplicates)
+}
+
+// isDuplicate records messageID and reports whether it was already handled.
+// If the store fails the message is treated as new, since handling it twice is better than dropping it.
+func (d *Dispatcher) isDuplicate(messageID string) bool {
+	d.mu.Lock()
+	store := d.store
+	d.mu.Unlock()
+
+	if store == nil || messageID == "" {
+		return false
+	}
+	seen, err := store.Seen(messageID)
+	if err != nil {
+		fmt.Println("EventSub failed to record message ID:", err)
+		return false
+	}
+	if seen {
+		atomic.AddUint64(&d.duplicates, 1)
+	}
+	return seen
+}
+
+// ListenRaw registers a handler function that is called with the undecoded event of any notification
+// whose subscription type and version has no typed handler registered.
+func (d *Dispatcher) ListenRaw(handler func(*Subscription, json.RawMessage)) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.rawHandler = handler
+}
+
+// UnlistenRaw removes the current raw handler function.
+func (d *Dispatcher) UnlistenRaw() {
+	d.ListenRaw(nil)
+}
+
+// ListenRevocation registers a handler function that is called when Twitch revokes a subscription.
+func (d *Dispatcher) ListenRevocation(handler func(*Subscription)) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.revocationHandler = handler
+}
+
+// UnlistenRevocation removes the current revocation handler function.
+func (d *Dispatcher) UnlistenRevocation() {
+	d.ListenRevocation(nil)
+}
+
+// setHandler registers a decoding handler for a version of a subscription type, or removes it if handler is nil.
+func (d *Dispatcher) setHandler(subscriptionType string, version string, handler func(*Subscription, json.RawMessage) error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	key := handlerKey{subscriptionType, version}
+	if handler == nil {
+		delete(d.handlers, key)
+		return
+	}
+	d.handlers[key] = handler
+}
+
+// listen registers a handler for a version of a subscription type that decodes each event into the value
+// returned by newEvent and passes it to call, which converts it to the event type of the typed handler.
+// The handler is removed if call is nil.
+func (d *Dispatcher) listen(subscriptionType string, version string, newEvent func() interface{}, call func(interface{})) {
+	if call == nil {
+		d.setHandler(subscriptionType, version, nil)
+		return
+	}
+	d.setHandler(subscriptionType, version, func(sub *Subscription, raw json.RawMessage) error {
+		event := newEvent()
+		if err := json.Unmarshal(raw, event); err != nil {
+			return err
+		}
+		call(event)
+		return nil
+	})
+}
+
+// dispatch decodes event and calls the handler registered for the subscription type and version.
+// Events without a typed handler are passed to the raw handler, or ignored if there is none.
+func (d *Dispatcher) dispatch(sub *Subscription, event json.RawMessage) error {
+	d.mu.Lock()
+	handler := d.handlers[handlerKey{sub.Type, sub.Version}]
+	rawHandler := d.rawHandler
+	d.mu.Unlock()
+
+	if handler == nil {
+		if rawHandler != nil {
+			rawHandler(sub, event)
+		}
+		return nil
+	}
+	if err := handler(sub, event); err != nil {
+		return fmt.Errorf("EventSub failed to handle %s v%s event: %v", sub.Type, sub.Version, err)
+	}
+	return nil
+}
+
+// revoke calls the revocation handler, if one is registered.
+func (d *Dispatcher) revoke(sub *Subscription) {
+	d.mu.Lock()
+	handler := d.revocationHandler
+	d.mu.Unlock()
+
+	if handler != nil {
+		handler(sub)
+	}
+}
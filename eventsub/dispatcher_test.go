@@ -0,0 +1,89 @@
+package eventsub
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+// Tests that events are decoded by the handler registered for their type and version.
+func TestDispatchTyped(t *testing.T) {
+	d := NewDispatcher()
+	var follow *ChannelFollowEvent
+	d.ListenChannelFollow(func(e *ChannelFollowEvent) {
+		follow = e
+	})
+	var ban *ChannelBanEvent
+	d.ListenChannelBan(func(e *ChannelBanEvent) {
+		ban = e
+	})
+	var gift *ChannelSubscriptionGiftEvent
+	d.ListenChannelSubscriptionGift(func(e *ChannelSubscriptionGiftEvent) {
+		gift = e
+	})
+
+	events := []struct {
+		sub   Subscription
+		event string
+	}{
+		{Subscription{Type: "channel.follow", Version: "2"}, `{"user_id":"1234","user_login":"cool_user","broadcaster_user_id":"1337","followed_at":"2020-07-15T18:16:11.17106713Z"}`},
+		{Subscription{Type: "channel.ban", Version: "1"}, `{"user_id":"1234","broadcaster_user_id":"1337","reason":"Offensive language","ends_at":null,"is_permanent":true}`},
+		{Subscription{Type: "channel.subscription.gift", Version: "1"}, `{"user_id":null,"broadcaster_user_id":"1337","total":2,"tier":"1000","cumulative_total":null,"is_anonymous":true}`},
+	}
+	for _, e := range events {
+		if err := d.dispatch(&e.sub, json.RawMessage(e.event)); err != nil {
+			t.Error(err)
+		}
+	}
+
+	if follow == nil || follow.UserLogin != "cool_user" || follow.FollowedAt.IsZero() {
+		t.Error("channel.follow not decoded:", follow)
+	}
+	if ban == nil || !ban.IsPermanent || ban.EndsAt != nil {
+		t.Error("channel.ban not decoded:", ban)
+	}
+	if gift == nil || !gift.IsAnonymous || gift.Total != 2 || gift.CumulativeTotal != nil {
+		t.Error("channel.subscription.gift not decoded:", gift)
+	}
+}
+
+// Tests that unknown types and versions without a typed handler fall back to the raw handler.
+func TestDispatchRaw(t *testing.T) {
+	d := NewDispatcher()
+	typed := 0
+	d.ListenChannelFollow(func(e *ChannelFollowEvent) {
+		typed++
+	})
+	var raw []string
+	d.ListenRaw(func(sub *Subscription, event json.RawMessage) {
+		raw = append(raw, sub.Type+"/"+sub.Version)
+	})
+
+	d.dispatch(&Subscription{Type: "channel.follow", Version: "1"}, json.RawMessage(`{}`))
+	d.dispatch(&Subscription{Type: "channel.hype_train.begin", Version: "1"}, json.RawMessage(`{}`))
+	d.dispatch(&Subscription{Type: "channel.follow", Version: "2"}, json.RawMessage(`{}`))
+
+	if typed != 1 {
+		t.Errorf("wanted 1 typed event, got: %d", typed)
+	}
+	if len(raw) != 2 || raw[0] != "channel.follow/1" || raw[1] != "channel.hype_train.begin/1" {
+		t.Error("unexpected raw events:", raw)
+	}
+
+	d.UnlistenChannelFollow()
+	d.UnlistenRaw()
+	d.dispatch(&Subscription{Type: "channel.follow", Version: "2"}, json.RawMessage(`{}`))
+	if typed != 1 || len(raw) != 2 {
+		t.Error("handlers called after unlisten")
+	}
+}
+
+// Tests that events which fail to decode are reported as errors.
+func TestDispatchDecodeError(t *testing.T) {
+	d := NewDispatcher()
+	d.ListenChannelCheer(func(e *ChannelCheerEvent) {
+		t.Error("handler called with invalid event")
+	})
+	if err := d.dispatch(&Subscription{Type: "channel.cheer", Version: "1"}, json.RawMessage(`{"bits":"lots"}`)); err == nil {
+		t.Error("expected error")
+	}
+}
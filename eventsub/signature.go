@@ -0,0 +1,39 @@
+package eventsub
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+)
+
+const (
+	signaturePrefix = "sha256="
+)
+
+// Sign returns the value of the Twitch-Eventsub-Message-Signature header for a message.
+// The signature is the HMAC-SHA256 of the message ID, timestamp and body, keyed with the subscription secret.
+func Sign(secret string, messageID string, timestamp string, body []byte) string {
+	return signaturePrefix + hex.EncodeToString(computeHMAC(secret, messageID, timestamp, body))
+}
+
+// VerifySignature reports whether signature is a valid Twitch-Eventsub-Message-Signature for the message.
+// The comparison is done in constant time.
+func VerifySignature(secret string, messageID string, timestamp string, body []byte, signature string) bool {
+	if !strings.HasPrefix(signature, signaturePrefix) {
+		return false
+	}
+	got, err := hex.DecodeString(strings.TrimPrefix(signature, signaturePrefix))
+	if err != nil {
+		return false
+	}
+	return hmac.Equal(got, computeHMAC(secret, messageID, timestamp, body))
+}
+
+func computeHMAC(secret string, messageID string, timestamp string, body []byte) []byte {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(messageID))
+	mac.Write([]byte(timestamp))
+	mac.Write(body)
+	return mac.Sum(nil)
+}
@@ -0,0 +1,241 @@
+package eventsub
+
+import (
+	"bufio"
+	"container/list"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultMessageIDCapacity is the number of message IDs remembered by the default store of a receiver.
+	DefaultMessageIDCapacity = 10000
+
+	// DefaultMessageIDTTL is how long message IDs are remembered by the default store of a receiver.
+	// Timestamps are accepted up to MaxMessageAge either side of the current time, so a message can be
+	// replayed for twice that long before it is rejected as stale.
+	DefaultMessageIDTTL = 2 * MaxMessageAge
+
+	// Number of entries appended to a FileStore before it is rewritten without the expired ones.
+	fileStoreCompactThreshold = 1000
+)
+
+// MessageIDStore records the IDs of the messages a receiver has handled. Twitch delivers each message
+// at least once, so a receiver uses the store to acknowledge duplicates without dispatching them again.
+type MessageIDStore interface {
+	// Seen records id and reports whether it had already been recorded.
+	Seen(id string) (bool, error)
+}
+
+// memoryEntry is a message ID and the last time it was seen.
+type memoryEntry struct {
+	id     string
+	seenAt time.Time
+}
+
+// MemoryStore is a MessageIDStore that keeps the most recently seen message IDs in memory.
+// IDs are forgotten once they are older than the TTL or when the store is over capacity.
+type MemoryStore struct {
+	mu       *sync.Mutex
+	capacity int
+	ttl      time.Duration
+	order    *list.List
+	entries  map[string]*list.Element
+	now      func() time.Time
+}
+
+// NewMemoryStore returns a MemoryStore that remembers up to capacity message IDs for ttl.
+// Returns an error if capacity or ttl is not positive.
+func NewMemoryStore(capacity int, ttl time.Duration) (*MemoryStore, error) {
+	if capacity <= 0 || ttl <= 0 {
+		return nil, errors.New("EventSub message ID store capacity and TTL must be positive")
+	}
+	return newMemoryStore(capacity, ttl), nil
+}
+
+func newMemoryStore(capacity int, ttl time.Duration) *MemoryStore {
+	return &MemoryStore{
+		mu:       &sync.Mutex{},
+		capacity: capacity,
+		ttl:      ttl,
+		order:    list.New(),
+		entries:  make(map[string]*list.Element),
+		now:      time.Now,
+	}
+}
+
+// Seen records id and reports whether it was already in the store.
+func (s *MemoryStore) Seen(id string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	s.expire(now)
+
+	if e, ok := s.entries[id]; ok {
+		e.Value.(*memoryEntry).seenAt = now
+		s.order.MoveToFront(e)
+		return true, nil
+	}
+
+	s.entries[id] = s.order.PushFront(&memoryEntry{id: id, seenAt: now})
+	for s.order.Len() > s.capacity {
+		s.remove(s.order.Back())
+	}
+	return false, nil
+}
+
+// Len returns the number of message IDs in the store.
+func (s *MemoryStore) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.order.Len()
+}
+
+// expire removes the entries last seen longer than the TTL ago, which are always at the back of the list.
+func (s *MemoryStore) expire(now time.Time) {
+	for e := s.order.Back(); e != nil && now.Sub(e.Value.(*memoryEntry).seenAt) > s.ttl; e = s.order.Back() {
+		s.remove(e)
+	}
+}
+
+func (s *MemoryStore) remove(e *list.Element) {
+	s.order.Remove(e)
+	delete(s.entries, e.Value.(*memoryEntry).id)
+}
+
+// FileStore is a MessageIDStore that persists message IDs to a file so duplicates are
+// still recognised after a restart. IDs are forgotten once they are older than the TTL.
+type FileStore struct {
+	mu       *sync.Mutex
+	path     string
+	file     *os.File
+	ttl      time.Duration
+	entries  map[string]time.Time
+	appended int
+	now      func() time.Time
+}
+
+// NewFileStore opens or creates the store at path, remembering message IDs for ttl.
+// Expired IDs in an existing file are discarded. Returns an error if ttl is not positive.
+func NewFileStore(path string, ttl time.Duration) (*FileStore, error) {
+	if ttl <= 0 {
+		return nil, errors.New("EventSub message ID store TTL must be positive")
+	}
+	s := &FileStore{
+		mu:      &sync.Mutex{},
+		path:    path,
+		ttl:     ttl,
+		entries: make(map[string]time.Time),
+		now:     time.Now,
+	}
+	if err := s.load(); err != nil {
+		return nil, err
+	}
+	if err := s.compact(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Seen records id and reports whether it was already in the store. A duplicate is remembered for
+// another TTL from now, as in MemoryStore. Returns an error if the ID could not be written to the file.
+func (s *FileStore) Seen(id string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	seenAt, ok := s.entries[id]
+	seen := ok && now.Sub(seenAt) <= s.ttl
+
+	// The latest line for an ID wins when the file is loaded, so appending also refreshes a duplicate.
+	if _, err := fmt.Fprintf(s.file, "%s %d\n", id, now.UnixNano()); err != nil {
+		return seen, err
+	}
+	s.entries[id] = now
+	s.appended++
+
+	if s.appended >= fileStoreCompactThreshold {
+		if err := s.compact(); err != nil {
+			return seen, err
+		}
+	}
+	return seen, nil
+}
+
+// Close closes the underlying file.
+func (s *FileStore) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.file.Close()
+}
+
+// load reads the entries from the file, if it exists.
+func (s *FileStore) load() error {
+	f, err := os.Open(s.path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 2 {
+			continue
+		}
+		nanos, err := strconv.ParseInt(fields[1], 10, 64)
+		if err != nil {
+			continue
+		}
+		s.entries[fields[0]] = time.Unix(0, nanos)
+	}
+	return scanner.Err()
+}
+
+// compact drops the expired entries and rewrites the file with the remaining ones.
+func (s *FileStore) compact() error {
+	now := s.now()
+	tmp := s.path + ".tmp"
+	f, err := os.OpenFile(tmp, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+
+	w := bufio.NewWriter(f)
+	for id, seenAt := range s.entries {
+		if now.Sub(seenAt) > s.ttl {
+			delete(s.entries, id)
+			continue
+		}
+		fmt.Fprintf(w, "%s %d\n", id, seenAt.UnixNano())
+	}
+	if err := w.Flush(); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp, s.path); err != nil {
+		return err
+	}
+
+	if s.file != nil {
+		s.file.Close()
+	}
+	s.file, err = os.OpenFile(s.path, os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	s.appended = 0
+	return nil
+}
@@ -0,0 +1,105 @@
+package eventsub
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// Tests that the memory store reports duplicates and forgets IDs after the TTL or when over capacity.
+func TestMemoryStore(t *testing.T) {
+	if _, err := NewMemoryStore(0, time.Minute); err == nil {
+		t.Error("expected error for zero capacity")
+	}
+	if _, err := NewMemoryStore(2, -time.Minute); err == nil {
+		t.Error("expected error for negative TTL")
+	}
+
+	now := testNow
+	s, err := NewMemoryStore(2, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.now = func() time.Time {
+		return now
+	}
+
+	if seen, _ := s.Seen("a"); seen {
+		t.Error("a reported as seen")
+	}
+	if seen, _ := s.Seen("a"); !seen {
+		t.Error("duplicate a not reported")
+	}
+
+	// b and c push a out of the store.
+	s.Seen("b")
+	s.Seen("c")
+	if seen, _ := s.Seen("a"); seen {
+		t.Error("a not evicted over capacity")
+	}
+
+	now = now.Add(2 * time.Minute)
+	if seen, _ := s.Seen("c"); seen {
+		t.Error("c not expired after TTL")
+	}
+	if s.Len() != 1 {
+		t.Errorf("wanted 1 entry, got: %d", s.Len())
+	}
+}
+
+// Tests that the file store remembers IDs across reopening, refreshes duplicates and discards expired IDs.
+func TestFileStore(t *testing.T) {
+	if _, err := NewFileStore("unused", 0); err == nil {
+		t.Error("expected error for zero TTL")
+	}
+
+	dir, err := ioutil.TempDir("", "eventsub")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "ids")
+
+	// Entries are compacted against the real clock when the store is opened.
+	now := time.Now()
+	clock := func() time.Time {
+		return now
+	}
+
+	s, err := NewFileStore(path, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.now = clock
+	now = now.Add(-90 * time.Second)
+	s.Seen("a")
+	now = now.Add(90 * time.Second)
+	s.Seen("b")
+	s.Close()
+
+	s, err = NewFileStore(path, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+	s.now = clock
+
+	if seen, err := s.Seen("b"); err != nil || !seen {
+		t.Error("b not remembered after reopening:", err)
+	}
+	if seen, _ := s.Seen("a"); seen {
+		t.Error("a not expired after TTL")
+	}
+
+	// Each duplicate of b keeps it for another TTL.
+	now = now.Add(50 * time.Second)
+	if seen, _ := s.Seen("b"); !seen {
+		t.Error("duplicate b not reported")
+	}
+	now = now.Add(50 * time.Second)
+	if seen, _ := s.Seen("b"); !seen {
+		t.Error("b not refreshed by its duplicate")
+	}
+}
@@ -0,0 +1,51 @@
+package eventsub
+
+import (
+	"time"
+)
+
+const (
+	streamOnlineType     = "stream.online"
+	streamOnlineVersion  = "1"
+	streamOfflineType    = "stream.offline"
+	streamOfflineVersion = "1"
+)
+
+// StreamOnlineEvent contains information about a stream that went live.
+type StreamOnlineEvent struct {
+	ID                   string    `json:"id"`
+	BroadcasterUserID    string    `json:"broadcaster_user_id"`
+	BroadcasterUserLogin string    `json:"broadcaster_user_login"`
+	BroadcasterUserName  string    `json:"broadcaster_user_name"`
+	Type                 string    `json:"type"`
+	StartedAt            time.Time `json:"started_at"`
+}
+
+// StreamOfflineEvent contains information about a stream that ended.
+type StreamOfflineEvent struct {
+	BroadcasterUserID    string `json:"broadcaster_user_id"`
+	BroadcasterUserLogin string `json:"broadcaster_user_login"`
+	BroadcasterUserName  string `json:"broadcaster_user_name"`
+}
+
+// ListenStreamOnline registers a handler function for version 1 of the stream.online subscription type.
+// The handler will be called with a populated StreamOnlineEvent when the event is received.
+func (d *Dispatcher) ListenStreamOnline(handler func(*StreamOnlineEvent)) {
+	d.listen(streamOnlineType, streamOnlineVersion, func() interface{} { return new(StreamOnlineEvent) }, func(e interface{}) { handler(e.(*StreamOnlineEvent)) })
+}
+
+// UnlistenStreamOnline removes the current handler function for the stream.online subscription type.
+func (d *Dispatcher) UnlistenStreamOnline() {
+	d.listen(streamOnlineType, streamOnlineVersion, nil, nil)
+}
+
+// ListenStreamOffline registers a handler function for version 1 of the stream.offline subscription type.
+// The handler will be called with a populated StreamOfflineEvent when the event is received.
+func (d *Dispatcher) ListenStreamOffline(handler func(*StreamOfflineEvent)) {
+	d.listen(streamOfflineType, streamOfflineVersion, func() interface{} { return new(StreamOfflineEvent) }, func(e interface{}) { handler(e.(*StreamOfflineEvent)) })
+}
+
+// UnlistenStreamOffline removes the current handler function for the stream.offline subscription type.
+func (d *Dispatcher) UnlistenStreamOffline() {
+	d.listen(streamOfflineType, streamOfflineVersion, nil, nil)
+}
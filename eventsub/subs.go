@@ -0,0 +1,100 @@
+package eventsub
+
+const (
+	channelSubscribeType              = "channel.subscribe"
+	channelSubscribeVersion           = "1"
+	channelSubscriptionGiftType       = "channel.subscription.gift"
+	channelSubscriptionGiftVersion    = "1"
+	channelSubscriptionMessageType    = "channel.subscription.message"
+	channelSubscriptionMessageVersion = "1"
+)
+
+// ChannelSubscribeEvent contains information about a new subscription to a channel.
+// Resubscriptions are sent as a ChannelSubscriptionMessageEvent instead.
+type ChannelSubscribeEvent struct {
+	UserID               string `json:"user_id"`
+	UserLogin            string `json:"user_login"`
+	UserName             string `json:"user_name"`
+	BroadcasterUserID    string `json:"broadcaster_user_id"`
+	BroadcasterUserLogin string `json:"broadcaster_user_login"`
+	BroadcasterUserName  string `json:"broadcaster_user_name"`
+	Tier                 string `json:"tier"`
+	IsGift               bool   `json:"is_gift"`
+}
+
+// ChannelSubscriptionGiftEvent contains information about subscriptions gifted in a channel.
+// The user fields are empty and CumulativeTotal is nil if the gift was anonymous.
+type ChannelSubscriptionGiftEvent struct {
+	UserID               string `json:"user_id"`
+	UserLogin            string `json:"user_login"`
+	UserName             string `json:"user_name"`
+	BroadcasterUserID    string `json:"broadcaster_user_id"`
+	BroadcasterUserLogin string `json:"broadcaster_user_login"`
+	BroadcasterUserName  string `json:"broadcaster_user_name"`
+	Total                int    `json:"total"`
+	Tier                 string `json:"tier"`
+	CumulativeTotal      *int   `json:"cumulative_total"`
+	IsAnonymous          bool   `json:"is_anonymous"`
+}
+
+// ChannelSubscriptionMessageEvent contains information about a resubscription shared in chat.
+// StreakMonths is nil if the user chose not to share their streak.
+type ChannelSubscriptionMessageEvent struct {
+	UserID               string              `json:"user_id"`
+	UserLogin            string              `json:"user_login"`
+	UserName             string              `json:"user_name"`
+	BroadcasterUserID    string              `json:"broadcaster_user_id"`
+	BroadcasterUserLogin string              `json:"broadcaster_user_login"`
+	BroadcasterUserName  string              `json:"broadcaster_user_name"`
+	Tier                 string              `json:"tier"`
+	Message              SubscriptionMessage `json:"message"`
+	CumulativeMonths     int                 `json:"cumulative_months"`
+	StreakMonths         *int                `json:"streak_months"`
+	DurationMonths       int                 `json:"duration_months"`
+}
+
+// SubscriptionMessage represents the chat message sent with a resubscription.
+type SubscriptionMessage struct {
+	Text   string              `json:"text"`
+	Emotes []SubscriptionEmote `json:"emotes"`
+}
+
+// SubscriptionEmote represents the position of an emote in a SubscriptionMessage.
+type SubscriptionEmote struct {
+	Begin int    `json:"begin"`
+	End   int    `json:"end"`
+	ID    string `json:"id"`
+}
+
+// ListenChannelSubscribe registers a handler function for version 1 of the channel.subscribe subscription type.
+// The handler will be called with a populated ChannelSubscribeEvent when the event is received.
+func (d *Dispatcher) ListenChannelSubscribe(handler func(*ChannelSubscribeEvent)) {
+	d.listen(channelSubscribeType, channelSubscribeVersion, func() interface{} { return new(ChannelSubscribeEvent) }, func(e interface{}) { handler(e.(*ChannelSubscribeEvent)) })
+}
+
+// UnlistenChannelSubscribe removes the current handler function for the channel.subscribe subscription type.
+func (d *Dispatcher) UnlistenChannelSubscribe() {
+	d.listen(channelSubscribeType, channelSubscribeVersion, nil, nil)
+}
+
+// ListenChannelSubscriptionGift registers a handler function for version 1 of the channel.subscription.gift subscription type.
+// The handler will be called with a populated ChannelSubscriptionGiftEvent when the event is received.
+func (d *Dispatcher) ListenChannelSubscriptionGift(handler func(*ChannelSubscriptionGiftEvent)) {
+	d.listen(channelSubscriptionGiftType, channelSubscriptionGiftVersion, func() interface{} { return new(ChannelSubscriptionGiftEvent) }, func(e interface{}) { handler(e.(*ChannelSubscriptionGiftEvent)) })
+}
+
+// UnlistenChannelSubscriptionGift removes the current handler function for the channel.subscription.gift subscription type.
+func (d *Dispatcher) UnlistenChannelSubscriptionGift() {
+	d.listen(channelSubscriptionGiftType, channelSubscriptionGiftVersion, nil, nil)
+}
+
+// ListenChannelSubscriptionMessage registers a handler function for version 1 of the channel.subscription.message subscription type.
+// The handler will be called with a populated ChannelSubscriptionMessageEvent when the event is received.
+func (d *Dispatcher) ListenChannelSubscriptionMessage(handler func(*ChannelSubscriptionMessageEvent)) {
+	d.listen(channelSubscriptionMessageType, channelSubscriptionMessageVersion, func() interface{} { return new(ChannelSubscriptionMessageEvent) }, func(e interface{}) { handler(e.(*ChannelSubscriptionMessageEvent)) })
+}
+
+// UnlistenChannelSubscriptionMessage removes the current handler function for the channel.subscription.message subscription type.
+func (d *Dispatcher) UnlistenChannelSubscriptionMessage() {
+	d.listen(channelSubscriptionMessageType, channelSubscriptionMessageVersion, nil, nil)
+}
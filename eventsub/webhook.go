@@ -0,0 +1,128 @@
+package eventsub
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"time"
+)
+
+const (
+	// Headers sent by Twitch with every webhook message.
+	HeaderMessageID           = "Twitch-Eventsub-Message-Id"
+	HeaderMessageRetry        = "Twitch-Eventsub-Message-Retry"
+	HeaderMessageType         = "Twitch-Eventsub-Message-Type"
+	HeaderMessageSignature    = "Twitch-Eventsub-Message-Signature"
+	HeaderMessageTimestamp    = "Twitch-Eventsub-Message-Timestamp"
+	HeaderSubscriptionType    = "Twitch-Eventsub-Subscription-Type"
+	HeaderSubscriptionVersion = "Twitch-Eventsub-Subscription-Version"
+
+	// Message types sent in the HeaderMessageType header of webhook messages. Notifications and
+	// revocations are also sent to WebSocket clients.
+	MessageTypeNotification = "notification"
+	MessageTypeVerification = "webhook_callback_verification"
+	MessageTypeRevocation   = "revocation"
+
+	// MaxMessageAge is the oldest message timestamp accepted by a Webhook. Older messages are rejected as replays.
+	MaxMessageAge = 10 * time.Minute
+
+	maxBodySize = 1 << 20
+)
+
+// webhookMessage represents the body of a message sent to a webhook callback.
+type webhookMessage struct {
+	Challenge    string          `json:"challenge"`
+	Subscription Subscription    `json:"subscription"`
+	Event        json.RawMessage `json:"event"`
+}
+
+// Webhook is an http.Handler that receives EventSub notifications sent to a webhook callback.
+// Every message is authenticated with the subscription secret before it is handled.
+type Webhook struct {
+	*Dispatcher
+	secret string
+	now    func() time.Time
+}
+
+// NewWebhook returns a Webhook that verifies messages with secret, the secret used when creating the subscriptions.
+func NewWebhook(secret string) *Webhook {
+	return &Webhook{
+		Dispatcher: NewDispatcher(),
+		secret:     secret,
+		now:        time.Now,
+	}
+}
+
+// ServeHTTP verifies the signature and timestamp of a message, answers verification challenges,
+// handles revocations and dispatches notifications to the registered handlers.
+func (wh *Webhook) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, maxBodySize))
+	if err != nil {
+		http.Error(w, "could not read body", http.StatusBadRequest)
+		return
+	}
+
+	messageID := r.Header.Get(HeaderMessageID)
+	timestamp := r.Header.Get(HeaderMessageTimestamp)
+	if !VerifySignature(wh.secret, messageID, timestamp, body, r.Header.Get(HeaderMessageSignature)) {
+		http.Error(w, "invalid signature", http.StatusForbidden)
+		return
+	}
+	if err := wh.checkTimestamp(timestamp); err != nil {
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+
+	msg := new(webhookMessage)
+	if err := json.Unmarshal(body, msg); err != nil {
+		http.Error(w, "invalid body", http.StatusBadRequest)
+		return
+	}
+
+	switch r.Header.Get(HeaderMessageType) {
+	case MessageTypeVerification:
+		w.Header().Set("Content-Type", "text/plain")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(msg.Challenge))
+	case MessageTypeRevocation:
+		w.WriteHeader(http.StatusNoContent)
+		if wh.isDuplicate(messageID) {
+			return
+		}
+		wh.revoke(&msg.Subscription)
+	case MessageTypeNotification:
+		// Respond before handling so slow handlers do not cause Twitch to retry the message.
+		w.WriteHeader(http.StatusNoContent)
+		if f, ok := w.(http.Flusher); ok {
+			f.Flush()
+		}
+		// Duplicates are acknowledged so Twitch stops retrying them, but are not handled again.
+		if wh.isDuplicate(messageID) {
+			return
+		}
+		if err := wh.dispatch(&msg.Subscription, msg.Event); err != nil {
+			fmt.Println(err)
+		}
+	default:
+		http.Error(w, "unknown message type", http.StatusBadRequest)
+	}
+}
+
+// checkTimestamp returns an error if timestamp is not within MaxMessageAge of the current time.
+func (wh *Webhook) checkTimestamp(timestamp string) error {
+	sent, err := time.Parse(time.RFC3339Nano, timestamp)
+	if err != nil {
+		return fmt.Errorf("invalid timestamp")
+	}
+	age := wh.now().Sub(sent)
+	if age > MaxMessageAge || age < -MaxMessageAge {
+		return fmt.Errorf("stale timestamp")
+	}
+	return nil
+}
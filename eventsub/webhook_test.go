@@ -0,0 +1,150 @@
+package eventsub
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+const (
+	testSecret    = "s3cr3t-s3cr3t"
+	testMessageID = "befa7b53-d79d-478f-86b9-120f112b044e"
+)
+
+var testNow = time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+
+// Create a Webhook with a fixed clock.
+func newTestWebhook() *Webhook {
+	wh := NewWebhook(testSecret)
+	wh.now = func() time.Time {
+		return testNow
+	}
+	return wh
+}
+
+// Create a signed webhook request.
+func newTestRequest(messageType string, timestamp time.Time, body string) *http.Request {
+	ts := timestamp.Format(time.RFC3339Nano)
+	r := httptest.NewRequest(http.MethodPost, "/eventsub", bytes.NewBufferString(body))
+	r.Header.Set(HeaderMessageID, testMessageID)
+	r.Header.Set(HeaderMessageTimestamp, ts)
+	r.Header.Set(HeaderMessageType, messageType)
+	r.Header.Set(HeaderMessageSignature, Sign(testSecret, testMessageID, ts, []byte(body)))
+	return r
+}
+
+// Tests that notifications with a valid signature are dispatched to the registered handler.
+func TestWebhookNotification(t *testing.T) {
+	wh := newTestWebhook()
+	var got *StreamOnlineEvent
+	wh.ListenStreamOnline(func(e *StreamOnlineEvent) {
+		got = e
+	})
+
+	body := `{"subscription":{"id":"f1c2a387","type":"stream.online","version":"1","status":"enabled","condition":{"broadcaster_user_id":"1337"}},"event":{"id":"9001","broadcaster_user_id":"1337","broadcaster_user_login":"cool_user","type":"live","started_at":"2020-10-11T10:11:12.123Z"}}`
+	rec := httptest.NewRecorder()
+	wh.ServeHTTP(rec, newTestRequest(MessageTypeNotification, testNow, body))
+
+	if rec.Code != http.StatusNoContent {
+		t.Errorf("wanted: %d\n got: %d\n", http.StatusNoContent, rec.Code)
+	}
+	if got == nil || got.BroadcasterUserID != "1337" || got.Type != "live" {
+		t.Error("handler not called with decoded event:", got)
+	}
+}
+
+// Tests that messages with bad signatures or stale timestamps are rejected and not dispatched.
+func TestWebhookRejects(t *testing.T) {
+	wh := newTestWebhook()
+	called := false
+	wh.ListenStreamOffline(func(e *StreamOfflineEvent) {
+		called = true
+	})
+	body := `{"subscription":{"type":"stream.offline","version":"1"},"event":{"broadcaster_user_id":"1337"}}`
+
+	tampered := newTestRequest(MessageTypeNotification, testNow, body)
+	tampered.Header.Set(HeaderMessageSignature, Sign("wrong-secret", testMessageID, testNow.Format(time.RFC3339Nano), []byte(body)))
+
+	cases := []*http.Request{
+		tampered,
+		newTestRequest(MessageTypeNotification, testNow.Add(-MaxMessageAge-time.Second), body),
+		newTestRequest(MessageTypeNotification, testNow.Add(MaxMessageAge+time.Second), body),
+	}
+	for _, r := range cases {
+		rec := httptest.NewRecorder()
+		wh.ServeHTTP(rec, r)
+		if rec.Code != http.StatusForbidden {
+			t.Errorf("wanted: %d\n got: %d\n", http.StatusForbidden, rec.Code)
+		}
+	}
+	if called {
+		t.Error("handler called for a rejected message")
+	}
+}
+
+// Tests that verification challenges are answered with the challenge value.
+func TestWebhookVerification(t *testing.T) {
+	wh := newTestWebhook()
+	body := `{"challenge":"pogchamp-kappa-360noscope-vohiyo","subscription":{"id":"f1c2a387","status":"webhook_callback_verification_pending","type":"channel.follow","version":"2"}}`
+
+	rec := httptest.NewRecorder()
+	wh.ServeHTTP(rec, newTestRequest(MessageTypeVerification, testNow, body))
+
+	if rec.Code != http.StatusOK {
+		t.Errorf("wanted: %d\n got: %d\n", http.StatusOK, rec.Code)
+	}
+	if rec.Body.String() != "pogchamp-kappa-360noscope-vohiyo" {
+		t.Error("unexpected challenge response:", rec.Body.String())
+	}
+}
+
+// Tests that revocations are passed to the revocation handler, including conditions with values that
+// are not strings.
+func TestWebhookRevocation(t *testing.T) {
+	wh := newTestWebhook()
+	var revoked *Subscription
+	wh.ListenRevocation(func(s *Subscription) {
+		revoked = s
+	})
+	body := `{"subscription":{"id":"f1c2a387","status":"authorization_revoked","type":"channel.follow","version":"2","condition":{"broadcaster_user_id":"1337","is_test":true}}}`
+
+	rec := httptest.NewRecorder()
+	wh.ServeHTTP(rec, newTestRequest(MessageTypeRevocation, testNow, body))
+
+	if rec.Code != http.StatusNoContent {
+		t.Errorf("wanted: %d\n got: %d\n", http.StatusNoContent, rec.Code)
+	}
+	if revoked == nil || revoked.Status != "authorization_revoked" {
+		t.Fatal("revocation handler not called:", revoked)
+	}
+	if revoked.Condition["broadcaster_user_id"] != "1337" || revoked.Condition["is_test"] != true {
+		t.Error("unexpected condition:", revoked.Condition)
+	}
+}
+
+// Tests that duplicate notifications are acknowledged but only dispatched once.
+func TestWebhookDuplicate(t *testing.T) {
+	wh := newTestWebhook()
+	calls := 0
+	wh.ListenStreamOnline(func(e *StreamOnlineEvent) {
+		calls++
+	})
+	body := `{"subscription":{"id":"f1c2a387","type":"stream.online","version":"1","status":"enabled"},"event":{"id":"9001","broadcaster_user_id":"1337","type":"live"}}`
+
+	for i := 0; i < 3; i++ {
+		rec := httptest.NewRecorder()
+		wh.ServeHTTP(rec, newTestRequest(MessageTypeNotification, testNow, body))
+		if rec.Code != http.StatusNoContent {
+			t.Errorf("wanted: %d\n got: %d\n", http.StatusNoContent, rec.Code)
+		}
+	}
+
+	if calls != 1 {
+		t.Errorf("wanted 1 dispatch, got: %d", calls)
+	}
+	if wh.Duplicates() != 2 {
+		t.Errorf("wanted 2 duplicates, got: %d", wh.Duplicates())
+	}
+}
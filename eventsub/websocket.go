@@ -0,0 +1,405 @@
+package eventsub
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/gorilla/websocket"
+	"github.com/kelr/gundyr/helix"
+	"reflect"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultWebSocketURL is the Twitch EventSub WebSocket endpoint.
+	DefaultWebSocketURL = "wss://eventsub.wss.twitch.tv/ws"
+
+	messageTypeWelcome   = "session_welcome"
+	messageTypeKeepalive = "session_keepalive"
+	messageTypeReconnect = "session_reconnect"
+
+	welcomeTimeout       = 10 * time.Second
+	keepaliveGrace       = 5 * time.Second
+	initialReconnectTime = time.Second
+	maxReconnectTime     = 10 * time.Minute
+	transportWebSocket   = "websocket"
+)
+
+// Session represents an EventSub WebSocket session.
+type Session struct {
+	ID                      string    `json:"id"`
+	Status                  string    `json:"status"`
+	KeepaliveTimeoutSeconds int       `json:"keepalive_timeout_seconds"`
+	ReconnectURL            string    `json:"reconnect_url"`
+	ConnectedAt             time.Time `json:"connected_at"`
+}
+
+// websocketMessage represents a message sent over an EventSub WebSocket connection.
+type websocketMessage struct {
+	Metadata struct {
+		MessageID        string    `json:"message_id"`
+		MessageType      string    `json:"message_type"`
+		MessageTimestamp time.Time `json:"message_timestamp"`
+	} `json:"metadata"`
+	Payload struct {
+		Session      *Session        `json:"session"`
+		Subscription Subscription    `json:"subscription"`
+		Event        json.RawMessage `json:"event"`
+	} `json:"payload"`
+}
+
+// Websocket conn object interface for mocking
+type connection interface {
+	Close() error
+	ReadMessage() (messageType int, p []byte, err error)
+	SetReadDeadline(t time.Time) error
+}
+
+// Interface to allow for mocking the Helix client used to create subscriptions.
+type subscriber interface {
+	CreateEventSubSubscription(body *helix.CreateEventSubSubscriptionBody) (*helix.EventSubSubscriptionsResponse, error)
+}
+
+// Client represents a connection and its state to the Twitch EventSub WebSocket endpoint.
+// Subscriptions are created through Helix for the session ID whenever a new session is started.
+type Client struct {
+	*Dispatcher
+	// URL is the endpoint the Client connects to. Defaults to DefaultWebSocketURL.
+	URL string
+
+	helix         subscriber
+	mu            *sync.Mutex
+	conn          connection
+	session       *Session
+	subscriptions []helix.CreateEventSubSubscriptionBody
+	isConnected   bool
+	reconnecting  bool
+	closed        bool
+	generation    int
+	backoff       time.Duration
+	reconnectTime time.Duration
+	grace         time.Duration
+}
+
+// NewClient returns a new Client that creates its subscriptions with helixClient.
+// WebSocket subscriptions require helixClient to be authenticated with a user token.
+func NewClient(helixClient *helix.Client) *Client {
+	return &Client{
+		Dispatcher:    NewDispatcher(),
+		URL:           DefaultWebSocketURL,
+		helix:         helixClient,
+		mu:            &sync.Mutex{},
+		backoff:       initialReconnectTime,
+		reconnectTime: initialReconnectTime,
+		grace:         keepaliveGrace,
+	}
+}
+
+// IsConnected is a thread-safe check of whether or not the Client is connected.
+func (c *Client) IsConnected() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.isConnected
+}
+
+// SessionID returns the ID of the current session, or an empty string if the Client has never connected.
+func (c *Client) SessionID() string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.session == nil {
+		return ""
+	}
+	return c.session.ID
+}
+
+// Subscribe adds a subscription to the Client. If the Client is connected, the subscription is created
+// for the current session immediately, otherwise it is created when the Client connects.
+// Subscriptions are created again for each new session after the Client reconnects.
+func (c *Client) Subscribe(subscriptionType string, version string, condition helix.EventSubCondition) error {
+	body := helix.CreateEventSubSubscriptionBody{
+		Type:      subscriptionType,
+		Version:   version,
+		Condition: condition,
+	}
+
+	c.mu.Lock()
+	c.subscriptions = append(c.subscriptions, body)
+	connected := c.isConnected
+	var sessionID string
+	if c.session != nil {
+		sessionID = c.session.ID
+	}
+	c.mu.Unlock()
+
+	if !connected {
+		return nil
+	}
+	return c.subscribe(body, sessionID)
+}
+
+// Connect to the EventSub WebSocket endpoint and create all of the added subscriptions for the new session.
+// Will automatically reconnect with exponential backoff if the connection is lost or no keepalive
+// is received in time. Returns an error if the client is already connected, if the connection
+// could not be made, or if a subscription could not be created.
+func (c *Client) Connect() error {
+	c.mu.Lock()
+	if c.isConnected {
+		c.mu.Unlock()
+		return errors.New("EventSub Client is already connected")
+	}
+	c.closed = false
+	c.reconnecting = false
+	c.generation++
+	generation := c.generation
+	c.mu.Unlock()
+	return c.connect(generation)
+}
+
+// Close disconnects the client from the EventSub WebSocket endpoint and stops any reconnect attempts.
+// If the client is neither connected nor reconnecting, Close() will return an error.
+func (c *Client) Close() error {
+	c.mu.Lock()
+	c.closed = true
+	c.generation++
+	wasConnected := c.isConnected
+	wasReconnecting := c.reconnecting
+	c.isConnected = false
+	c.reconnecting = false
+	conn := c.conn
+	c.mu.Unlock()
+
+	if wasReconnecting {
+		return nil
+	}
+	if !wasConnected {
+		return errors.New("EventSub Client connection is already closed")
+	}
+	return conn.Close()
+}
+
+// connect opens a new session and creates every subscription for it. The session is discarded if
+// the Client was closed or connected again since generation was started by Connect or reconnect.
+func (c *Client) connect(generation int) error {
+	c.mu.Lock()
+	url := c.URL
+	c.mu.Unlock()
+
+	conn, session, err := c.open(url)
+	if err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	if c.closed || c.generation != generation {
+		c.mu.Unlock()
+		conn.Close()
+		return errors.New("EventSub Client was closed while connecting")
+	}
+	c.conn = conn
+	c.session = session
+	c.isConnected = true
+	c.reconnecting = false
+	c.reconnectTime = c.backoff
+	subscriptions := append([]helix.CreateEventSubSubscriptionBody(nil), c.subscriptions...)
+	c.mu.Unlock()
+
+	go c.reader(conn, session)
+	fmt.Println("EventSub Client connected:", session.ID)
+
+	var firstErr error
+	for _, body := range subscriptions {
+		if err := c.subscribe(body, session.ID); err != nil {
+			fmt.Println("EventSub failed to subscribe to", body.Type+":", err)
+			if firstErr == nil {
+				firstErr = err
+			}
+		}
+	}
+	return firstErr
+}
+
+// open dials url and waits for the session welcome message.
+func (c *Client) open(url string) (connection, *Session, error) {
+	conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	conn.SetReadDeadline(time.Now().Add(welcomeTimeout))
+	_, raw, err := conn.ReadMessage()
+	if err != nil {
+		conn.Close()
+		return nil, nil, err
+	}
+
+	msg := new(websocketMessage)
+	if err := json.Unmarshal(raw, msg); err != nil {
+		conn.Close()
+		return nil, nil, err
+	}
+	if msg.Metadata.MessageType != messageTypeWelcome || msg.Payload.Session == nil {
+		conn.Close()
+		return nil, nil, errors.New("EventSub expected session_welcome, got: " + msg.Metadata.MessageType)
+	}
+	return conn, msg.Payload.Session, nil
+}
+
+// subscribe creates a subscription for the session through Helix.
+func (c *Client) subscribe(body helix.CreateEventSubSubscriptionBody, sessionID string) error {
+	body.Transport = helix.EventSubTransport{
+		Method:    transportWebSocket,
+		SessionID: sessionID,
+	}
+	_, err := c.helix.CreateEventSubSubscription(&body)
+	return err
+}
+
+// reader reads messages from conn until it fails or is replaced by a reconnect.
+// The read deadline is extended by the session keepalive timeout after every message.
+func (c *Client) reader(conn connection, session *Session) {
+	for {
+		if session.KeepaliveTimeoutSeconds > 0 {
+			keepalive := time.Duration(session.KeepaliveTimeoutSeconds) * time.Second
+			conn.SetReadDeadline(time.Now().Add(keepalive + c.grace))
+		} else {
+			conn.SetReadDeadline(time.Time{})
+		}
+
+		_, raw, err := conn.ReadMessage()
+		if err != nil {
+			c.mu.Lock()
+			current := c.conn == conn && !c.closed
+			if current {
+				c.isConnected = false
+				c.reconnecting = true
+			}
+			generation := c.generation
+			c.mu.Unlock()
+
+			// Connections closed by Close or replaced by a reconnect are expected to fail.
+			if current {
+				fmt.Println("EventSub error in rx:", err)
+				conn.Close()
+				go c.reconnect(generation)
+			}
+			return
+		}
+		c.handle(conn, raw)
+	}
+}
+
+// handle processes a single message received on conn.
+func (c *Client) handle(conn connection, raw []byte) {
+	msg := new(websocketMessage)
+	if err := json.Unmarshal(raw, msg); err != nil {
+		fmt.Println("EventSub received invalid message:", err)
+		return
+	}
+
+	switch msg.Metadata.MessageType {
+	case messageTypeKeepalive:
+	case MessageTypeNotification:
+		if c.isDuplicate(msg.Metadata.MessageID) {
+			return
+		}
+		if err := c.dispatch(&msg.Payload.Subscription, msg.Payload.Event); err != nil {
+			fmt.Println(err)
+		}
+	case MessageTypeRevocation:
+		if c.isDuplicate(msg.Metadata.MessageID) {
+			return
+		}
+		c.forget(&msg.Payload.Subscription)
+		c.revoke(&msg.Payload.Subscription)
+	case messageTypeReconnect:
+		if msg.Payload.Session == nil || msg.Payload.Session.ReconnectURL == "" {
+			fmt.Println("EventSub received session_reconnect without a reconnect URL")
+			return
+		}
+		go c.migrate(conn, msg.Payload.Session.ReconnectURL)
+	default:
+		fmt.Println("EventSub received unknown message type:", msg.Metadata.MessageType)
+	}
+}
+
+// migrate connects to the reconnect URL and replaces old once the new session is welcomed.
+// The old connection keeps being read until then so no events are lost. Subscriptions carry
+// over to the new connection, so they are not created again.
+func (c *Client) migrate(old connection, url string) {
+	conn, session, err := c.open(url)
+	if err != nil {
+		// Twitch closes the old connection shortly, which will trigger a full reconnect.
+		fmt.Println("EventSub failed to follow reconnect:", err)
+		return
+	}
+
+	c.mu.Lock()
+	if c.conn != old || c.closed {
+		c.mu.Unlock()
+		conn.Close()
+		return
+	}
+	c.conn = conn
+	c.session = session
+	c.reconnectTime = c.backoff
+	c.mu.Unlock()
+
+	go c.reader(conn, session)
+	old.Close()
+}
+
+// Updates the exponential backoff reconnect time and attempts to reconnect
+// to the EventSub WebSocket endpoint after this time period, until connected or closed.
+// Stops if the Client is closed or connected again by Connect, so only one session is ever open.
+func (c *Client) reconnect(generation int) {
+	for {
+		c.mu.Lock()
+		if c.closed || c.isConnected || c.generation != generation {
+			c.mu.Unlock()
+			return
+		}
+		wait := c.reconnectTime
+		if c.reconnectTime < maxReconnectTime {
+			c.reconnectTime *= 2
+		}
+		c.mu.Unlock()
+
+		fmt.Println("EventSub Client lost connection, retrying in:", wait)
+		time.Sleep(wait)
+
+		// Close and Connect may have been called while sleeping.
+		c.mu.Lock()
+		stale := c.closed || c.isConnected || c.generation != generation
+		c.mu.Unlock()
+		if stale {
+			return
+		}
+		if err := c.connect(generation); err != nil {
+			fmt.Println("EventSub failed to reconnect:", err)
+		}
+	}
+}
+
+// forget removes a revoked subscription so it is not created again on reconnect.
+func (c *Client) forget(sub *Subscription) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	kept := c.subscriptions[:0]
+	for _, body := range c.subscriptions {
+		if body.Type == sub.Type && body.Version == sub.Version && sameCondition(&body.Condition, sub.Condition) {
+			continue
+		}
+		kept = append(kept, body)
+	}
+	c.subscriptions = kept
+}
+
+// sameCondition returns true if the helix condition has exactly the fields and values in condition.
+// The helix condition is round tripped through JSON so its values have the same types as condition.
+func sameCondition(a *helix.EventSubCondition, condition map[string]interface{}) bool {
+	b, _ := json.Marshal(a)
+	fields := make(map[string]interface{})
+	json.Unmarshal(b, &fields)
+	return reflect.DeepEqual(fields, condition)
+}
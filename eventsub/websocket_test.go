@@ -0,0 +1,218 @@
+package eventsub
+
+import (
+	"fmt"
+	"github.com/gorilla/websocket"
+	"github.com/kelr/gundyr/helix"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+const (
+	testWelcome      = `{"metadata":{"message_id":"96a3f3b5","message_type":"session_welcome"},"payload":{"session":{"id":"AQoQexAW","status":"connected","keepalive_timeout_seconds":%d}}}`
+	testNotification = `{"metadata":{"message_id":"%s","message_type":"notification"},"payload":{"subscription":{"id":"f1c2a387","type":"stream.online","version":"1","status":"enabled","condition":{"broadcaster_user_id":"1337"}},"event":{"id":"%s","broadcaster_user_id":"1337","type":"live"}}}`
+	testReconnect    = `{"metadata":{"message_id":"84c1e79a","message_type":"session_reconnect"},"payload":{"session":{"id":"AQoQexAW","status":"reconnecting","reconnect_url":"%s"}}}`
+)
+
+// Records the subscriptions created by a Client.
+type mockSubscriber struct {
+	mu     sync.Mutex
+	bodies []helix.CreateEventSubSubscriptionBody
+}
+
+func (m *mockSubscriber) CreateEventSubSubscription(body *helix.CreateEventSubSubscriptionBody) (*helix.EventSubSubscriptionsResponse, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.bodies = append(m.bodies, *body)
+	return &helix.EventSubSubscriptionsResponse{}, nil
+}
+
+func (m *mockSubscriber) count() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return len(m.bodies)
+}
+
+// Create a Client connected to a local test server.
+func newTestClient(url string, sub *mockSubscriber) *Client {
+	c := NewClient(nil)
+	c.helix = sub
+	c.URL = url
+	c.backoff = 10 * time.Millisecond
+	c.reconnectTime = c.backoff
+	c.grace = 0
+	return c
+}
+
+// Upgrade a test server request, write msgs and hold the connection open until the client closes it.
+func serveMessages(t *testing.T, w http.ResponseWriter, r *http.Request, msgs ...string) {
+	upgrader := websocket.Upgrader{}
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer conn.Close()
+	for _, msg := range msgs {
+		if err := conn.WriteMessage(websocket.TextMessage, []byte(msg)); err != nil {
+			return
+		}
+	}
+	for {
+		if _, _, err := conn.ReadMessage(); err != nil {
+			return
+		}
+	}
+}
+
+// Tests that the client subscribes for its session, dispatches notifications and follows a session
+// reconnect without losing the events sent on the old connection.
+func TestClientReconnect(t *testing.T) {
+	var srv *httptest.Server
+	srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		wsURL := "ws" + strings.TrimPrefix(srv.URL, "http")
+		if r.URL.Path == "/reconnect" {
+			serveMessages(t, w, r, fmt.Sprintf(testWelcome, 10), fmt.Sprintf(testNotification, "m3", "3"))
+			return
+		}
+		serveMessages(t, w, r,
+			fmt.Sprintf(testWelcome, 10),
+			fmt.Sprintf(testNotification, "m1", "1"),
+			fmt.Sprintf(testReconnect, wsURL+"/reconnect"),
+			fmt.Sprintf(testNotification, "m2", "2"),
+		)
+	}))
+	defer srv.Close()
+
+	sub := new(mockSubscriber)
+	c := newTestClient("ws"+strings.TrimPrefix(srv.URL, "http")+"/ws", sub)
+	events := make(chan string, 3)
+	c.ListenStreamOnline(func(e *StreamOnlineEvent) {
+		events <- e.ID
+	})
+
+	if err := c.Subscribe("stream.online", "1", helix.EventSubCondition{BroadcasterUserID: "1337"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Connect(); err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+
+	got := make(map[string]bool)
+	for i := 0; i < 3; i++ {
+		select {
+		case id := <-events:
+			got[id] = true
+		case <-time.After(2 * time.Second):
+			t.Fatal("timed out waiting for events, got:", got)
+		}
+	}
+	if !got["1"] || !got["2"] || !got["3"] {
+		t.Error("missing events:", got)
+	}
+
+	if sub.count() != 1 {
+		t.Fatalf("wanted 1 subscription, got: %d", sub.count())
+	}
+	if b := sub.bodies[0]; b.Transport.Method != "websocket" || b.Transport.SessionID != "AQoQexAW" {
+		t.Error("unexpected transport:", b.Transport)
+	}
+}
+
+// Tests that the client reconnects and subscribes again when no keepalive is received in time.
+func TestClientKeepaliveTimeout(t *testing.T) {
+	var mu sync.Mutex
+	connections := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		connections++
+		mu.Unlock()
+		serveMessages(t, w, r, fmt.Sprintf(testWelcome, 1))
+	}))
+	defer srv.Close()
+
+	sub := new(mockSubscriber)
+	c := newTestClient("ws"+strings.TrimPrefix(srv.URL, "http"), sub)
+	c.Subscribe("stream.online", "1", helix.EventSubCondition{BroadcasterUserID: "1337"})
+	if err := c.Connect(); err != nil {
+		t.Fatal(err)
+	}
+
+	deadline := time.Now().Add(3 * time.Second)
+	for sub.count() < 2 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	c.Close()
+
+	mu.Lock()
+	defer mu.Unlock()
+	if connections < 2 || sub.count() < 2 {
+		t.Errorf("wanted a reconnect and new subscription, got %d connections and %d subscriptions", connections, sub.count())
+	}
+}
+
+// Tests that a pending reconnect is abandoned when the client is closed and connected again while it waits,
+// so only one session is opened, and that closing the client while it waits is not an error.
+func TestClientReconnectAfterConnect(t *testing.T) {
+	var mu sync.Mutex
+	connections := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		connections++
+		first := connections == 1
+		mu.Unlock()
+		if first {
+			// Drop the first connection right after the welcome to start a reconnect.
+			upgrader := websocket.Upgrader{}
+			conn, err := upgrader.Upgrade(w, r, nil)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			conn.WriteMessage(websocket.TextMessage, []byte(fmt.Sprintf(testWelcome, 10)))
+			conn.Close()
+			return
+		}
+		serveMessages(t, w, r, fmt.Sprintf(testWelcome, 10))
+	}))
+	defer srv.Close()
+
+	sub := new(mockSubscriber)
+	c := newTestClient("ws"+strings.TrimPrefix(srv.URL, "http"), sub)
+	c.backoff = 200 * time.Millisecond
+	c.reconnectTime = c.backoff
+	if err := c.Connect(); err != nil {
+		t.Fatal(err)
+	}
+
+	deadline := time.Now().Add(time.Second)
+	for c.IsConnected() && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	if c.IsConnected() {
+		t.Fatal("connection was not dropped")
+	}
+	if err := c.Close(); err != nil {
+		t.Error("expected Close to stop the pending reconnect, got:", err)
+	}
+	if err := c.Close(); err == nil {
+		t.Error("expected error for closing twice")
+	}
+	if err := c.Connect(); err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+
+	// Wait past the reconnect backoff.
+	time.Sleep(3 * c.backoff)
+	mu.Lock()
+	defer mu.Unlock()
+	if connections != 2 {
+		t.Errorf("wanted 2 connections, got %d", connections)
+	}
+}
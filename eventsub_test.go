@@ -0,0 +1,160 @@
+package gundyr
+
+import (
+	"errors"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/kelr/gundyr/helix"
+)
+
+// Returns a desired webhook subscription to stream.online for broadcasterID.
+func newDesiredEventSub(broadcasterID string) helix.CreateEventSubSubscriptionBody {
+	return helix.CreateEventSubSubscriptionBody{
+		Type:      "stream.online",
+		Version:   "1",
+		Condition: helix.EventSubCondition{BroadcasterUserID: broadcasterID},
+		Transport: helix.EventSubTransport{Method: "webhook", Callback: "https://example.com/eventsub", Secret: "s3cr3t-s3cr3t"},
+	}
+}
+
+// Returns the existing subscription for a desired subscription.
+func newExistingEventSub(id string, status string, body helix.CreateEventSubSubscriptionBody) helix.EventSubSubscription {
+	body.Transport.Secret = ""
+	return helix.EventSubSubscription{
+		ID:        id,
+		Status:    status,
+		Type:      body.Type,
+		Version:   body.Version,
+		Condition: body.Condition,
+		Transport: body.Transport,
+		Cost:      1,
+	}
+}
+
+// Tests that ReconcileEventSub keeps matching active subscriptions, deletes unwanted and inactive ones,
+// creates missing ones and reports the total cost after the changes.
+func TestReconcileEventSub(t *testing.T) {
+	kept := newDesiredEventSub("1")
+	revoked := newDesiredEventSub("2")
+	missing := newDesiredEventSub("3")
+	other := newDesiredEventSub("4")
+	other.Version = "beta"
+
+	current := []helix.EventSubSubscription{
+		newExistingEventSub("a", "enabled", kept),
+		newExistingEventSub("b", "authorization_revoked", revoked),
+		newExistingEventSub("c", "enabled", other),
+	}
+	total := 2
+
+	var deleted []string
+	var created []string
+	mock := &mockHelixClient{
+		getEventSubSubscriptions: func(opt *helix.GetEventSubSubscriptionsOpt) (*helix.EventSubSubscriptionsResponse, error) {
+			// Serve one subscription per page to check every page is read.
+			page, _ := strconv.Atoi(opt.After)
+			resp := &helix.EventSubSubscriptionsResponse{
+				Data:         current[page : page+1],
+				Total:        len(current),
+				TotalCost:    total,
+				MaxTotalCost: 10000,
+			}
+			if page+1 < len(current) {
+				resp.Pagination.Cursor = strconv.Itoa(page + 1)
+			}
+			return resp, nil
+		},
+		deleteEventSubSubscription: func(opt *helix.DeleteEventSubSubscriptionOpt) error {
+			deleted = append(deleted, opt.ID)
+			return nil
+		},
+		createEventSubSubscription: func(body *helix.CreateEventSubSubscriptionBody) (*helix.EventSubSubscriptionsResponse, error) {
+			created = append(created, body.Condition.BroadcasterUserID)
+			total++
+			return &helix.EventSubSubscriptionsResponse{
+				Data:         []helix.EventSubSubscription{newExistingEventSub("new-"+body.Condition.BroadcasterUserID, "enabled", *body)},
+				TotalCost:    total,
+				MaxTotalCost: 10000,
+			}, nil
+		},
+	}
+	c := &Helix{client: mock}
+
+	diff, err := c.ReconcileEventSub([]helix.CreateEventSubSubscriptionBody{kept, revoked, missing})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(deleted, []string{"b", "c"}) {
+		t.Error("unexpected deletes:", deleted)
+	}
+	if !reflect.DeepEqual(created, []string{"2", "3"}) {
+		t.Error("unexpected creates:", created)
+	}
+	if len(diff.Deleted) != 2 || len(diff.Created) != 2 || diff.Created[1].ID != "new-3" {
+		t.Error("unexpected diff:", diff)
+	}
+	if diff.TotalCost != 4 || diff.MaxTotalCost != 10000 {
+		t.Errorf("unexpected cost: %d of %d", diff.TotalCost, diff.MaxTotalCost)
+	}
+
+	// Without creates, the total cost is the listed cost less the active subscriptions deleted.
+	deleted, created = nil, nil
+	total = 2
+	diff, err = c.ReconcileEventSub([]helix.CreateEventSubSubscriptionBody{kept})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(deleted, []string{"b", "c"}) || created != nil {
+		t.Error("unexpected changes:", deleted, created)
+	}
+	if diff.TotalCost != 1 || diff.MaxTotalCost != 10000 {
+		t.Errorf("unexpected cost: %d of %d", diff.TotalCost, diff.MaxTotalCost)
+	}
+
+	// Changes made before an error are reported.
+	failure := errors.New("failure")
+	mock.createEventSubSubscription = func(body *helix.CreateEventSubSubscriptionBody) (*helix.EventSubSubscriptionsResponse, error) {
+		return nil, failure
+	}
+	diff, err = c.ReconcileEventSub([]helix.CreateEventSubSubscriptionBody{kept, missing})
+	if err != failure || len(diff.Deleted) != 2 || len(diff.Created) != 0 {
+		t.Error("unexpected result:", diff, err)
+	}
+}
+
+// Tests that subscriptions only match when their type, version, condition and transport all match.
+func TestEventSubKey(t *testing.T) {
+	base := newDesiredEventSub("1")
+	key := func(b helix.CreateEventSubSubscriptionBody) subscriptionKey {
+		return eventSubKey(b.Type, b.Version, &b.Condition, &b.Transport)
+	}
+
+	same := base
+	same.Transport.Secret = "different"
+	if key(same) != key(base) {
+		t.Error("secret should not affect the key")
+	}
+
+	changes := []func(b *helix.CreateEventSubSubscriptionBody){
+		func(b *helix.CreateEventSubSubscriptionBody) { b.Type = "stream.offline" },
+		func(b *helix.CreateEventSubSubscriptionBody) { b.Version = "2" },
+		func(b *helix.CreateEventSubSubscriptionBody) { b.Condition.BroadcasterUserID = "2" },
+		func(b *helix.CreateEventSubSubscriptionBody) { b.Condition.ModeratorUserID = "1" },
+		func(b *helix.CreateEventSubSubscriptionBody) { b.Transport.Callback = "https://example.com/other" },
+		func(b *helix.CreateEventSubSubscriptionBody) {
+			b.Transport = helix.EventSubTransport{Method: "websocket", SessionID: "session"}
+		},
+		func(b *helix.CreateEventSubSubscriptionBody) {
+			b.Transport = helix.EventSubTransport{Method: "conduit", ConduitID: "conduit"}
+		},
+	}
+	for i, change := range changes {
+		changed := base
+		change(&changed)
+		if key(changed) == key(base) {
+			t.Errorf("change %d should affect the key", i)
+		}
+	}
+}
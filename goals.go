@@ -0,0 +1,202 @@
+package gundyr
+
+import (
+	"errors"
+	"fmt"
+	"github.com/kelr/gundyr/helix"
+	"math/big"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// GoalProgress represents the progress toward a charity campaign or creator goal.
+// Type is charity for a charity campaign, or the creator goal type. Current and Target are
+// exact decimal strings, and Percent is the progress toward Target for display.
+type GoalProgress struct {
+	ID          string
+	Type        string
+	Description string
+	Current     string
+	Target      string
+	Currency    string
+	Percent     float64
+}
+
+// GoalPoller periodically checks a broadcaster's charity campaign and creator goals and reports progress changes.
+type GoalPoller struct {
+	BroadcasterID string
+	PollPeriod    time.Duration
+
+	// Charity and Goals select whether the charity campaign and creator goals are polled.
+	// Charity requires scope channel:read:charity and Goals requires scope channel:read:goals.
+	Charity bool
+	Goals   bool
+
+	client   helixClient
+	handler  func(*GoalProgress)
+	progress map[string]GoalProgress
+	stop     chan bool
+	done     chan bool
+	mu       *sync.Mutex
+}
+
+// NewGoalPoller returns a GoalPoller that checks the goals of broadcasterID every pollPeriod and calls handler
+// with the progress of each goal the first time it is seen and whenever its progress changes.
+// Both the charity campaign and creator goals are polled by default.
+func (c *Helix) NewGoalPoller(broadcasterID string, pollPeriod time.Duration, handler func(*GoalProgress)) *GoalPoller {
+	return &GoalPoller{
+		BroadcasterID: broadcasterID,
+		PollPeriod:    pollPeriod,
+		Charity:       true,
+		Goals:         true,
+		client:        c.client,
+		handler:       handler,
+		progress:      make(map[string]GoalProgress),
+		mu:            &sync.Mutex{},
+	}
+}
+
+// Start begins polling in the background. Returns an error if the poller is already running.
+func (p *GoalPoller) Start() error {
+	if p.PollPeriod <= 0 {
+		return errors.New("Goal poller poll period must be positive")
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.stop != nil {
+		return errors.New("Goal poller is already running")
+	}
+	p.stop = make(chan bool)
+	p.done = make(chan bool)
+	go p.run(p.stop, p.done)
+	return nil
+}
+
+// Stop stops polling and waits for a poll in progress to finish, so the handler is not called
+// after Stop returns. Must not be called from the handler. Returns an error if the poller is not running.
+func (p *GoalPoller) Stop() error {
+	p.mu.Lock()
+	stop, done := p.stop, p.done
+	p.stop, p.done = nil, nil
+	p.mu.Unlock()
+
+	if stop == nil {
+		return errors.New("Goal poller is not running")
+	}
+	close(stop)
+	<-done
+	return nil
+}
+
+func (p *GoalPoller) run(stop chan bool, done chan bool) {
+	defer close(done)
+	ticker := time.NewTicker(p.PollPeriod)
+	defer ticker.Stop()
+	for {
+		if err := p.Poll(); err != nil {
+			fmt.Println("Goal poller error:", err)
+		}
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Poll checks the goals once and calls the handler for every goal whose progress changed.
+// The charity campaign and creator goals are polled independently, so a failure to get one does not
+// stop changes to the other from being reported. Returns the errors of both combined.
+func (p *GoalPoller) Poll() error {
+	var current []GoalProgress
+	var charityErr, goalsErr error
+
+	if p.Charity {
+		var response *helix.GetCharityCampaignResponse
+		response, charityErr = p.client.GetCharityCampaign(&helix.GetCharityCampaignOpt{
+			BroadcasterID: p.BroadcasterID,
+		})
+		if charityErr == nil {
+			for _, c := range response.Data {
+				current = append(current, GoalProgress{
+					ID:          c.ID,
+					Type:        "charity",
+					Description: c.CharityName,
+					Current:     c.CurrentAmount.String(),
+					Target:      c.TargetAmount.String(),
+					Currency:    c.CurrentAmount.Currency,
+					Percent:     percentOf(c.CurrentAmount.Rat(), c.TargetAmount.Rat()),
+				})
+			}
+		}
+	}
+
+	if p.Goals {
+		var response *helix.GetCreatorGoalsResponse
+		response, goalsErr = p.client.GetCreatorGoals(&helix.GetCreatorGoalsOpt{
+			BroadcasterID: p.BroadcasterID,
+		})
+		if goalsErr == nil {
+			for _, g := range response.Data {
+				current = append(current, GoalProgress{
+					ID:          g.ID,
+					Type:        g.Type,
+					Description: g.Description,
+					Current:     strconv.Itoa(g.CurrentAmount),
+					Target:      strconv.Itoa(g.TargetAmount),
+					Percent:     percentOf(big.NewRat(int64(g.CurrentAmount), 1), big.NewRat(int64(g.TargetAmount), 1)),
+				})
+			}
+		}
+	}
+
+	p.mu.Lock()
+	var changed []GoalProgress
+	seen := make(map[string]bool, len(current))
+	for _, g := range current {
+		seen[g.ID] = true
+		if last, ok := p.progress[g.ID]; ok && last == g {
+			continue
+		}
+		p.progress[g.ID] = g
+		changed = append(changed, g)
+	}
+	// Forget goals that ended so they are reported again if they restart. Goals that could not be
+	// polled are kept so they are not reported again when polling recovers.
+	for id, g := range p.progress {
+		failed := goalsErr != nil
+		if g.Type == "charity" {
+			failed = charityErr != nil
+		}
+		if !seen[id] && !failed {
+			delete(p.progress, id)
+		}
+	}
+	p.mu.Unlock()
+
+	if p.handler != nil {
+		for i := range changed {
+			p.handler(&changed[i])
+		}
+	}
+
+	switch {
+	case charityErr != nil && goalsErr != nil:
+		return fmt.Errorf("Goal poller failed to get charity campaign: %v, and creator goals: %v", charityErr, goalsErr)
+	case charityErr != nil:
+		return charityErr
+	}
+	return goalsErr
+}
+
+// percentOf returns current as a percentage of target, or 0 if there is no target.
+func percentOf(current *big.Rat, target *big.Rat) float64 {
+	if target.Sign() == 0 {
+		return 0
+	}
+	ratio := new(big.Rat).Quo(current, target)
+	percent, _ := ratio.Mul(ratio, big.NewRat(100, 1)).Float64()
+	return percent
+}
@@ -0,0 +1,159 @@
+package gundyr
+
+import (
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kelr/gundyr/helix"
+)
+
+// Tests that Poll reports goals when first seen and when their progress changes, and keeps polling
+// creator goals when the charity campaign fails and the other way around.
+func TestGoalPollerPoll(t *testing.T) {
+	var charity *helix.GetCharityCampaignResponse
+	var goals *helix.GetCreatorGoalsResponse
+	var charityErr, goalsErr error
+	mock := &mockHelixClient{
+		getCharityCampaign: func(opt *helix.GetCharityCampaignOpt) (*helix.GetCharityCampaignResponse, error) {
+			return charity, charityErr
+		},
+		getCreatorGoals: func(opt *helix.GetCreatorGoalsOpt) (*helix.GetCreatorGoalsResponse, error) {
+			return goals, goalsErr
+		},
+	}
+	c := &Helix{client: mock}
+
+	var reported []string
+	p := c.NewGoalPoller("1337", 0, func(g *GoalProgress) {
+		reported = append(reported, g.ID+"="+g.Current)
+	})
+
+	setCharity := func(value int64) {
+		charity = &helix.GetCharityCampaignResponse{Data: []helix.GetCharityCampaignData{{
+			ID:            "charity",
+			CharityName:   "Example",
+			CurrentAmount: helix.CharityAmount{Value: value, DecimalPlaces: 2, Currency: "USD"},
+			TargetAmount:  helix.CharityAmount{Value: 100000, DecimalPlaces: 2, Currency: "USD"},
+		}}}
+	}
+	setGoal := func(value int) {
+		goals = &helix.GetCreatorGoalsResponse{Data: []helix.GetCreatorGoalsData{
+			{ID: "goal", Type: "follower", CurrentAmount: value, TargetAmount: 100},
+		}}
+	}
+	failure := errors.New("failure")
+
+	steps := []struct {
+		name       string
+		charity    int64
+		goal       int
+		charityErr error
+		goalsErr   error
+		want       []string
+	}{
+		{"first poll", 550, 10, nil, nil, []string{"charity=5.50", "goal=10"}},
+		{"unchanged", 550, 10, nil, nil, nil},
+		{"changed", 600, 11, nil, nil, []string{"charity=6.00", "goal=11"}},
+		{"charity fails", 700, 12, failure, nil, []string{"goal=12"}},
+		{"charity recovers unchanged", 600, 12, nil, nil, nil},
+		{"goals fail", 650, 13, nil, failure, []string{"charity=6.50"}},
+		{"goals recover changed", 650, 14, nil, nil, []string{"goal=14"}},
+	}
+	for _, step := range steps {
+		setCharity(step.charity)
+		setGoal(step.goal)
+		charityErr, goalsErr = step.charityErr, step.goalsErr
+		reported = nil
+
+		err := p.Poll()
+		want := step.charityErr
+		if want == nil {
+			want = step.goalsErr
+		}
+		if err != want {
+			t.Errorf("%s: wanted: %v\n got: %v\n", step.name, want, err)
+		}
+		if !reflect.DeepEqual(reported, step.want) {
+			t.Errorf("%s: wanted: %v\n got: %v\n", step.name, step.want, reported)
+		}
+	}
+
+	// Both errors are returned when both fail.
+	charityErr, goalsErr = errors.New("charity failure"), errors.New("goals failure")
+	err := p.Poll()
+	if err == nil || err.Error() != "Goal poller failed to get charity campaign: charity failure, and creator goals: goals failure" {
+		t.Error("unexpected error:", err)
+	}
+
+	// Ended goals are forgotten and reported again when they restart.
+	charityErr, goalsErr = nil, nil
+	goals = &helix.GetCreatorGoalsResponse{}
+	p.Poll()
+	setGoal(14)
+	reported = nil
+	p.Poll()
+	if !reflect.DeepEqual(reported, []string{"goal=14"}) {
+		t.Error("restarted goal not reported:", reported)
+	}
+}
+
+// Tests that Stop waits for the running poll so the handler is not called after it returns.
+func TestGoalPollerStop(t *testing.T) {
+	var mu sync.Mutex
+	reported := 0
+	entered := make(chan bool, 1)
+	release := make(chan bool)
+
+	mock := &mockHelixClient{
+		getCreatorGoals: func(opt *helix.GetCreatorGoalsOpt) (*helix.GetCreatorGoalsResponse, error) {
+			select {
+			case entered <- true:
+				<-release
+			default:
+			}
+			return &helix.GetCreatorGoalsResponse{Data: []helix.GetCreatorGoalsData{
+				{ID: "goal", Type: "follower", CurrentAmount: 1, TargetAmount: 10},
+			}}, nil
+		},
+	}
+	c := &Helix{client: mock}
+	p := c.NewGoalPoller("1337", time.Millisecond, func(g *GoalProgress) {
+		mu.Lock()
+		reported++
+		mu.Unlock()
+	})
+	p.Charity = false
+
+	if err := p.Start(); err != nil {
+		t.Fatal(err)
+	}
+	if err := p.Start(); err == nil {
+		t.Error("expected error for starting twice")
+	}
+	<-entered
+
+	stopped := make(chan bool)
+	go func() {
+		p.Stop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+		t.Fatal("Stop returned while a poll was running")
+	case <-time.After(20 * time.Millisecond):
+	}
+	close(release)
+	<-stopped
+
+	mu.Lock()
+	defer mu.Unlock()
+	if reported != 1 {
+		t.Error("wanted the running poll to be reported before Stop returned, got reports:", reported)
+	}
+	if err := p.Stop(); err == nil {
+		t.Error("expected error for stopping twice")
+	}
+}
@@ -2,19 +2,71 @@
 package gundyr
 
 import (
+	"bufio"
 	"errors"
 	"github.com/kelr/gundyr/helix"
+	"github.com/kelr/gundyr/pubsub"
 	"golang.org/x/oauth2"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
 )
 
 var pageMem = make(map[string]int)
 
+// Maximum number of batched lookups run at once by fetchBatches.
+const maxBatchWorkers = 4
+
+// Number of attempts and the initial backoff used when updating entitlements.
+const (
+	entitlementAttempts = 4
+	entitlementBackoff  = time.Second
+)
+
+// The extension slot types in the order they are filled, and the number of slots Twitch provides for each.
+var extensionSlotTypes = []string{"panel", "overlay", "component"}
+var extensionSlotCounts = map[string]int{
+	"panel":     3,
+	"overlay":   1,
+	"component": 2,
+}
+
 // Interface to allow for mocking a Helix Client.
 type helixClient interface {
 	GetUsers(opt *helix.GetUsersOpt) (*helix.GetUsersResponse, error)
-	GetUsersFollows(opt *helix.GetUsersFollowsOpt) (*helix.GetUsersFollowsResponse, error)
+	GetChannelFollowers(opt *helix.GetChannelFollowersOpt) (*helix.GetChannelFollowersResponse, error)
 	GetClips(opt *helix.GetClipsOpt) (*helix.GetClipsResponse, error)
 	GetVideos(opt *helix.GetVideosOpt) (*helix.GetVideosResponse, error)
+	SendWhisper(fromUserID string, toUserID string, message string) error
+	GetUserBlockList(opt *helix.GetUserBlockListOpt) (*helix.GetUserBlockListResponse, error)
+	BlockUser(opt *helix.BlockUserOpt) error
+	UnblockUser(opt *helix.UnblockUserOpt) error
+	GetUserExtensions() (*helix.GetUserExtensionsResponse, error)
+	GetUserActiveExtensions(opt *helix.GetUserActiveExtensionsOpt) (*helix.GetUserActiveExtensionsResponse, error)
+	UpdateUserExtensions(data *helix.GetUserActiveExtensionsData) (*helix.GetUserActiveExtensionsResponse, error)
+	UpdateDropsEntitlements(body *helix.UpdateDropsEntitlementsBody) (*helix.UpdateDropsEntitlementsResponse, error)
+	GetTeams(opt *helix.GetTeamsOpt) (*helix.GetTeamsResponse, error)
+	GetStreams(opt *helix.GetStreamsOpt) (*helix.GetStreamsResponse, error)
+	GetGames(opt *helix.GetGamesOpt) (*helix.GetGamesResponse, error)
+	StartCommercial(body *helix.StartCommercialBody) (*helix.StartCommercialResponse, error)
+	StartRaid(opt *helix.StartRaidOpt) (*helix.StartRaidResponse, error)
+	GetVIPs(opt *helix.GetVIPsOpt) (*helix.GetVIPsResponse, error)
+	AddChannelVIP(opt *helix.ChannelVIPOpt) error
+	RemoveChannelVIP(opt *helix.ChannelVIPOpt) error
+	ManageHeldAutoModMessage(body *helix.ManageHeldAutoModMessageBody) error
+	GetCharityCampaign(opt *helix.GetCharityCampaignOpt) (*helix.GetCharityCampaignResponse, error)
+	GetCreatorGoals(opt *helix.GetCreatorGoalsOpt) (*helix.GetCreatorGoalsResponse, error)
+	UpdateShieldModeStatus(opt *helix.ShieldModeOpt, active bool) (*helix.ShieldModeResponse, error)
+	GetModeratedChannels(opt *helix.GetModeratedChannelsOpt) (*helix.GetModeratedChannelsResponse, error)
+	CreateEventSubSubscription(body *helix.CreateEventSubSubscriptionBody) (*helix.EventSubSubscriptionsResponse, error)
+	GetEventSubSubscriptions(opt *helix.GetEventSubSubscriptionsOpt) (*helix.EventSubSubscriptionsResponse, error)
+	DeleteEventSubSubscription(opt *helix.DeleteEventSubSubscriptionOpt) error
+	GetConduitShards(opt *helix.GetConduitShardsOpt) (*helix.GetConduitShardsResponse, error)
+	UpdateConduitShards(body *helix.UpdateConduitShardsBody) (*helix.UpdateConduitShardsResponse, error)
 }
 
 // HelixConfig represents configuration options available to a Client.
@@ -29,6 +81,7 @@ type HelixConfig struct {
 // Helix is a wrapper over a HelixClient. See https://godoc.org/github.com/kelr/gundyr/helix for the underlying HelixClient.
 type Helix struct {
 	client helixClient
+	sleep  func(time.Duration)
 }
 
 // NewHelix returns returns a client credentials Helix API client wrapper
@@ -39,7 +92,8 @@ func NewHelix(cfg *HelixConfig) (*Helix, error) {
 		return nil, err
 	}
 	return &Helix{
-		client,
+		client: client,
+		sleep:  time.Sleep,
 	}, nil
 }
 
@@ -140,13 +194,15 @@ func (c *Helix) GetUserEmail(username string) (string, error) {
 
 // GetFollowers returns userIDs for all the users following the provided userID.
 // "Who is following userID?"
+// The user access token must belong to userID or one of their moderators and have scope moderator:read:followers.
 func (c *Helix) GetFollowers(userID string) ([]string, error) {
 	var followers []string
-	opt := &helix.GetUsersFollowsOpt{
-		ToID: userID,
+	opt := &helix.GetChannelFollowersOpt{
+		BroadcasterID: userID,
+		First:         100,
 	}
 
-	response, err := c.client.GetUsersFollows(opt)
+	response, err := c.client.GetChannelFollowers(opt)
 	if err != nil {
 		return followers, err
 	}
@@ -154,15 +210,19 @@ func (c *Helix) GetFollowers(userID string) ([]string, error) {
 	// Drain all the followers by checking each page until there are none left.
 	for len(response.Data) > 0 {
 		for _, d := range response.Data {
-			followers = append(followers, d.FromID)
+			followers = append(followers, d.UserID)
+		}
+		if response.Pagination.Cursor == "" {
+			break
 		}
 
-		opt = &helix.GetUsersFollowsOpt{
-			ToID:  userID,
-			After: response.Pagination.Cursor,
+		opt = &helix.GetChannelFollowersOpt{
+			BroadcasterID: userID,
+			First:         100,
+			After:         response.Pagination.Cursor,
 		}
 
-		response, err = c.client.GetUsersFollows(opt)
+		response, err = c.client.GetChannelFollowers(opt)
 		if err != nil {
 			return followers, err
 		}
@@ -218,7 +278,7 @@ func (c *Helix) GetAllVideos(broadcasterID string) ([]helix.GetVideosData, error
 
 	opt := &helix.GetVideosOpt{
 		UserID: broadcasterID,
-		First:  "100",
+		First:  100,
 	}
 
 	response, err := c.client.GetVideos(opt)
@@ -242,7 +302,7 @@ func (c *Helix) GetAllVideos(broadcasterID string) ([]helix.GetVideosData, error
 		opt = &helix.GetVideosOpt{
 			UserID: broadcasterID,
 			After:  response.Pagination.Cursor,
-			First:  "100",
+			First:  100,
 		}
 
 		response, err = c.client.GetVideos(opt)
@@ -253,3 +313,465 @@ func (c *Helix) GetAllVideos(broadcasterID string) ([]helix.GetVideosData, error
 	pageMem = make(map[string]int)
 	return videos, nil
 }
+
+// ReplyWhisper sends message as a whisper back to the sender of a whisper received through PubSub.
+// The reply is sent from the recipient of the received whisper, which must be the user of the access token.
+// Requires scope: user:manage:whispers
+func (c *Helix) ReplyWhisper(whisper *pubsub.WhispersData, message string) error {
+	if whisper == nil {
+		return errors.New("Helix: Cannot reply to a nil whisper.")
+	}
+	return c.client.SendWhisper(strconv.Itoa(whisper.Recipient.ID), strconv.Itoa(whisper.FromID), message)
+}
+
+// BlockListDiff represents the changes made to an account's block list by SyncBlockList.
+type BlockListDiff struct {
+	Blocked   []string
+	Unblocked []string
+}
+
+// GetBlockList returns the user IDs of all the users blocked by broadcasterID.
+// Requires scope: user:read:blocked_users
+func (c *Helix) GetBlockList(broadcasterID string) ([]string, error) {
+	var blocked []string
+	opt := &helix.GetUserBlockListOpt{
+		BroadcasterID: broadcasterID,
+		First:         100,
+	}
+
+	response, err := c.client.GetUserBlockList(opt)
+	if err != nil {
+		return nil, err
+	}
+
+	// Drain the block list by checking each page until there are none left.
+	for len(response.Data) > 0 {
+		for _, d := range response.Data {
+			blocked = append(blocked, d.UserID)
+		}
+		if response.Pagination.Cursor == "" {
+			break
+		}
+
+		opt = &helix.GetUserBlockListOpt{
+			BroadcasterID: broadcasterID,
+			First:         100,
+			After:         response.Pagination.Cursor,
+		}
+
+		response, err = c.client.GetUserBlockList(opt)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return blocked, nil
+}
+
+// SyncBlockList makes the block list of broadcasterID match the user IDs listed in file.
+// The file contains one user ID per line. Blank lines and lines starting with # are ignored.
+// Users in the file that are not blocked are blocked, and blocked users missing from the file are unblocked.
+// Returns the changes made, including any made before an error occurred.
+// Requires scopes: user:read:blocked_users and user:manage:blocked_users
+func (c *Helix) SyncBlockList(broadcasterID string, file string) (*BlockListDiff, error) {
+	wanted, err := readBlockListFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := c.GetBlockList(broadcasterID)
+	if err != nil {
+		return nil, err
+	}
+
+	blocked := make(map[string]bool)
+	for _, id := range current {
+		blocked[id] = true
+	}
+
+	diff := new(BlockListDiff)
+	for _, id := range wanted {
+		if blocked[id] {
+			delete(blocked, id)
+			continue
+		}
+		if err := c.client.BlockUser(&helix.BlockUserOpt{TargetUserID: id}); err != nil {
+			return diff, err
+		}
+		diff.Blocked = append(diff.Blocked, id)
+	}
+
+	// Anything left over is blocked on the account but not listed in the file.
+	for _, id := range current {
+		if !blocked[id] {
+			continue
+		}
+		if err := c.client.UnblockUser(&helix.UnblockUserOpt{TargetUserID: id}); err != nil {
+			return diff, err
+		}
+		diff.Unblocked = append(diff.Unblocked, id)
+	}
+	return diff, nil
+}
+
+// readBlockListFile returns the unique user IDs listed in a block list file in the order they appear.
+func readBlockListFile(file string) ([]string, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var ids []string
+	seen := make(map[string]bool)
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || seen[line] {
+			continue
+		}
+		seen[line] = true
+		ids = append(ids, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// ActivateExtension activates the installed extension extensionID for userID in the first free slot compatible
+// with the extension. Panel slots are tried first, then overlay and component slots.
+// Returns the slot type and slot number the extension is active in. If the extension is already active,
+// its current slot is returned and nothing is changed.
+// Requires scopes: user:read:broadcast and user:edit:broadcast
+func (c *Helix) ActivateExtension(userID string, extensionID string) (string, string, error) {
+	installed, err := c.client.GetUserExtensions()
+	if err != nil {
+		return "", "", err
+	}
+
+	var extension *helix.GetUsersExtensionsData
+	for i, e := range installed.Data {
+		if e.ID == extensionID {
+			extension = &installed.Data[i]
+			break
+		}
+	}
+	if extension == nil {
+		return "", "", errors.New("Extension: " + extensionID + " is not installed")
+	}
+	if !extension.CanActivate {
+		return "", "", errors.New("Extension: " + extensionID + " cannot be activated")
+	}
+
+	response, err := c.client.GetUserActiveExtensions(&helix.GetUserActiveExtensionsOpt{
+		UserID: userID,
+	})
+	if err != nil {
+		return "", "", err
+	}
+	active := response.Data
+
+	// Leave the extension where it is if it is already active.
+	for _, slotType := range extensionSlotTypes {
+		for slot, e := range *extensionSlots(&active, slotType) {
+			if e.Active && e.ID == extensionID {
+				return slotType, slot, nil
+			}
+		}
+	}
+
+	for _, slotType := range extensionSlotTypes {
+		if !hasExtensionType(extension, slotType) {
+			continue
+		}
+		slots := *extensionSlots(&active, slotType)
+		for i := 1; i <= extensionSlotCounts[slotType]; i++ {
+			slot := strconv.Itoa(i)
+			if slots[slot].Active {
+				continue
+			}
+
+			update := helix.ActiveExtension{
+				Active:  true,
+				ID:      extension.ID,
+				Version: extension.Version,
+			}
+			data := new(helix.GetUserActiveExtensionsData)
+			*extensionSlots(data, slotType) = map[string]helix.ActiveExtension{slot: update}
+			if _, err := c.client.UpdateUserExtensions(data); err != nil {
+				return "", "", err
+			}
+			return slotType, slot, nil
+		}
+	}
+	return "", "", errors.New("Extension: " + extensionID + " has no free compatible slot")
+}
+
+func hasExtensionType(extension *helix.GetUsersExtensionsData, slotType string) bool {
+	for _, t := range extension.Type {
+		if t == slotType {
+			return true
+		}
+	}
+	return false
+}
+
+// extensionSlots returns a pointer to the slot map of slotType in data.
+func extensionSlots(data *helix.GetUserActiveExtensionsData, slotType string) *map[string]helix.ActiveExtension {
+	switch slotType {
+	case "overlay":
+		return &data.Overlay
+	case "component":
+		return &data.Component
+	default:
+		return &data.Panel
+	}
+}
+
+// UpdateEntitlements sets the fulfillment status of any number of entitlements, updating them in batches of 100.
+// Entitlements that fail with UPDATE_FAILED, and batches that fail with a rate limit or server error, are retried
+// with exponential backoff. Retrying is safe since setting an entitlement to the same status is idempotent.
+// Returns the final update status of each entitlement ID, including those updated before an error occurred.
+func (c *Helix) UpdateEntitlements(entitlementIDs []string, status string) (map[string]string, error) {
+	results := make(map[string]string)
+
+	for start := 0; start < len(entitlementIDs); start += helix.MaxEntitlementsPerUpdate {
+		end := start + helix.MaxEntitlementsPerUpdate
+		if end > len(entitlementIDs) {
+			end = len(entitlementIDs)
+		}
+
+		pending := entitlementIDs[start:end]
+		backoff := entitlementBackoff
+		for attempt := 1; len(pending) > 0; attempt++ {
+			response, err := c.client.UpdateDropsEntitlements(&helix.UpdateDropsEntitlementsBody{
+				EntitlementIDs:    pending,
+				FulfillmentStatus: status,
+			})
+			if err != nil {
+				if !isRetryable(err) || attempt == entitlementAttempts {
+					return results, err
+				}
+			} else {
+				var failed []string
+				for _, d := range response.Data {
+					for _, id := range d.IDs {
+						results[id] = d.Status
+						if d.Status == "UPDATE_FAILED" {
+							failed = append(failed, id)
+						}
+					}
+				}
+				pending = failed
+				if len(pending) == 0 || attempt == entitlementAttempts {
+					break
+				}
+			}
+			c.sleep(backoff)
+			backoff *= 2
+		}
+	}
+	return results, nil
+}
+
+// isRetryable returns whether err is a Helix API error that may succeed if the request is retried.
+func isRetryable(err error) bool {
+	apiErr, ok := err.(*helix.APIError)
+	if !ok {
+		return false
+	}
+	return apiErr.Status == http.StatusTooManyRequests || apiErr.Status >= http.StatusInternalServerError
+}
+
+// TeamMemberStatus represents the live status of a member of a team.
+// Title, GameID and ViewerCount are only set when the member is live.
+type TeamMemberStatus struct {
+	UserID      string
+	UserLogin   string
+	UserName    string
+	Live        bool
+	Title       string
+	GameID      string
+	ViewerCount int
+}
+
+// TeamLiveStatus returns the live status of every member of the team teamName, in the order Twitch lists the members.
+// Streams are looked up with GetStreamsByUserID.
+func (c *Helix) TeamLiveStatus(teamName string) ([]TeamMemberStatus, error) {
+	teams, err := c.client.GetTeams(&helix.GetTeamsOpt{
+		Name: teamName,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(teams.Data) == 0 {
+		return nil, errors.New("Team: " + teamName + " not found")
+	}
+
+	members := teams.Data[0].Users
+	statuses := make([]TeamMemberStatus, len(members))
+	index := make(map[string]int, len(members))
+	for i, m := range members {
+		statuses[i] = TeamMemberStatus{
+			UserID:    m.UserID,
+			UserLogin: m.UserLogin,
+			UserName:  m.UserName,
+		}
+		index[m.UserID] = i
+	}
+
+	ids := make([]string, len(members))
+	for i, m := range members {
+		ids[i] = m.UserID
+	}
+
+	streams, err := c.GetStreamsByUserID(ids)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, stream := range streams {
+		i, ok := index[stream.UserID]
+		if !ok {
+			continue
+		}
+		statuses[i].Live = stream.Type == "live"
+		statuses[i].Title = stream.Title
+		statuses[i].GameID = stream.GameID
+		statuses[i].ViewerCount = stream.ViewerCount
+	}
+	return statuses, nil
+}
+
+// fetchBatches splits ids into batches of at most helix.MaxQueryValues and calls fetch with each batch,
+// running at most maxBatchWorkers requests at once to stay within Helix rate limits. Once every batch
+// has succeeded, merge is called with the result of each batch in order. Returns the error of the first
+// failed batch, in which case batches that have not started are skipped and merge is not called.
+func fetchBatches(ids []string, fetch func(batch []string) (interface{}, error), merge func(result interface{})) error {
+	batches := (len(ids) + helix.MaxQueryValues - 1) / helix.MaxQueryValues
+	results := make([]interface{}, batches)
+	errs := make([]error, batches)
+
+	jobs := make(chan int)
+	var failed int32
+	var wg sync.WaitGroup
+	for w := 0; w < maxBatchWorkers && w < batches; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for b := range jobs {
+				if atomic.LoadInt32(&failed) != 0 {
+					continue
+				}
+				end := (b + 1) * helix.MaxQueryValues
+				if end > len(ids) {
+					end = len(ids)
+				}
+				results[b], errs[b] = fetch(ids[b*helix.MaxQueryValues : end])
+				if errs[b] != nil {
+					atomic.StoreInt32(&failed, 1)
+				}
+			}
+		}()
+	}
+	for b := 0; b < batches; b++ {
+		jobs <- b
+	}
+	close(jobs)
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	for _, r := range results {
+		merge(r)
+	}
+	return nil
+}
+
+// GetStreamsByUserID returns the active streams of any number of users.
+// Users are looked up concurrently in batches of 100 and the results are merged in batch order.
+func (c *Helix) GetStreamsByUserID(userIDs []string) ([]helix.GetStreamsData, error) {
+	var streams []helix.GetStreamsData
+	err := fetchBatches(userIDs, func(batch []string) (interface{}, error) {
+		response, err := c.client.GetStreams(&helix.GetStreamsOpt{
+			UserID: batch,
+			First:  helix.MaxQueryValues,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return response.Data, nil
+	}, func(result interface{}) {
+		streams = append(streams, result.([]helix.GetStreamsData)...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return streams, nil
+}
+
+// GetGamesByID returns information about any number of games.
+// Games are looked up concurrently in batches of 100 and the results are merged in batch order.
+func (c *Helix) GetGamesByID(gameIDs []string) ([]helix.GetGamesData, error) {
+	var games []helix.GetGamesData
+	err := fetchBatches(gameIDs, func(batch []string) (interface{}, error) {
+		response, err := c.client.GetGames(&helix.GetGamesOpt{
+			ID: batch,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return response.Data, nil
+	}, func(result interface{}) {
+		games = append(games, result.([]helix.GetGamesData)...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return games, nil
+}
+
+// GetClipsByID returns information about any number of clips.
+// Clips are looked up concurrently in batches of 100 and the results are merged in batch order.
+func (c *Helix) GetClipsByID(clipIDs []string) ([]helix.GetClipsData, error) {
+	var clips []helix.GetClipsData
+	err := fetchBatches(clipIDs, func(batch []string) (interface{}, error) {
+		response, err := c.client.GetClips(&helix.GetClipsOpt{
+			ID: batch,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return response.Data, nil
+	}, func(result interface{}) {
+		clips = append(clips, result.([]helix.GetClipsData)...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return clips, nil
+}
+
+// GetVideosByID returns information about any number of videos.
+// Videos are looked up concurrently in batches of 100 and the results are merged in batch order.
+func (c *Helix) GetVideosByID(videoIDs []string) ([]helix.GetVideosData, error) {
+	var videos []helix.GetVideosData
+	err := fetchBatches(videoIDs, func(batch []string) (interface{}, error) {
+		response, err := c.client.GetVideos(&helix.GetVideosOpt{
+			ID: batch,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return response.Data, nil
+	}, func(result interface{}) {
+		videos = append(videos, result.([]helix.GetVideosData)...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return videos, nil
+}
@@ -0,0 +1,163 @@
+package helix
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+)
+
+const (
+	startCommercialPath = "/channels/commercial"
+	getAdSchedulePath   = "/channels/ads"
+	snoozeNextAdPath    = "/channels/ads/schedule/snooze"
+
+	// MinCommercialLength is the shortest commercial length in seconds accepted by Start Commercial.
+	MinCommercialLength = 30
+
+	// MaxCommercialLength is the longest commercial length in seconds accepted by Start Commercial.
+	MaxCommercialLength = 180
+)
+
+// StartCommercialBody represents the request body of a Start Commercial command.
+type StartCommercialBody struct {
+	BroadcasterID string `json:"broadcaster_id"`
+	Length        int    `json:"length"`
+}
+
+// StartCommercialData represents the result of starting a commercial.
+// Length is the length of the commercial that was run and RetryAfter is the number of
+// seconds until the next commercial can be run.
+type StartCommercialData struct {
+	Length     int    `json:"length"`
+	Message    string `json:"message,omitempty"`
+	RetryAfter int    `json:"retry_after"`
+}
+
+// StartCommercialResponse represents a response from a Start Commercial command.
+type StartCommercialResponse struct {
+	Data []StartCommercialData `json:"data,omitempty"`
+}
+
+// StartCommercial starts a commercial on the broadcaster's channel. The channel must be live.
+// Length must be between 30 and 180 seconds.
+// Requires scope: channel:edit:commercial
+//
+// https://dev.twitch.tv/docs/api/reference#start-commercial
+func (client *Client) StartCommercial(body *StartCommercialBody) (*StartCommercialResponse, error) {
+	if client.tokenType != "user" {
+		return nil, errors.New("Helix: Start Commercial endpoint requires a user token for authentication.")
+	}
+	if !client.hasScope("channel:edit:commercial") {
+		return nil, errors.New("Helix: Missing required scope for Start Commercial- channel:edit:commercial")
+	}
+	if body.Length < MinCommercialLength || body.Length > MaxCommercialLength {
+		return nil, errors.New("Helix: Commercial length must be between 30 and 180 seconds.")
+	}
+
+	data := new(StartCommercialResponse)
+	resp, err := client.jsonRequest(startCommercialPath, nil, body, http.MethodPost)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkResponse(resp); err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(resp.Data, data)
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// GetAdScheduleOpt defines the options available for Get Ad Schedule.
+type GetAdScheduleOpt struct {
+	BroadcasterID string `url:"broadcaster_id"`
+}
+
+// GetAdScheduleData represents the ad schedule of a channel.
+// Duration and PrerollFreeTime are in seconds. Timestamps are empty if not applicable.
+type GetAdScheduleData struct {
+	NextAdAt        string `json:"next_ad_at,omitempty"`
+	LastAdAt        string `json:"last_ad_at,omitempty"`
+	Duration        int    `json:"duration,omitempty"`
+	PrerollFreeTime int    `json:"preroll_free_time,omitempty"`
+	SnoozeCount     int    `json:"snooze_count,omitempty"`
+	SnoozeRefreshAt string `json:"snooze_refresh_at,omitempty"`
+}
+
+// GetAdScheduleResponse represents a response from a Get Ad Schedule command.
+type GetAdScheduleResponse struct {
+	Data []GetAdScheduleData `json:"data,omitempty"`
+}
+
+// GetAdSchedule returns the ad schedule, snoozes and preroll free time of the broadcaster's channel.
+// Requires scope: channel:read:ads
+//
+// https://dev.twitch.tv/docs/api/reference#get-ad-schedule
+func (client *Client) GetAdSchedule(opt *GetAdScheduleOpt) (*GetAdScheduleResponse, error) {
+	if client.tokenType != "user" {
+		return nil, errors.New("Helix: Get Ad Schedule endpoint requires a user token for authentication.")
+	}
+	if !client.hasScope("channel:read:ads") {
+		return nil, errors.New("Helix: Missing required scope for Get Ad Schedule- channel:read:ads")
+	}
+
+	data := new(GetAdScheduleResponse)
+	resp, err := client.getRequest(getAdSchedulePath, opt)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkResponse(resp); err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(resp.Data, data)
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// SnoozeNextAdOpt defines the options available for Snooze Next Ad.
+type SnoozeNextAdOpt struct {
+	BroadcasterID string `url:"broadcaster_id"`
+}
+
+// SnoozeNextAdData represents the ad schedule of a channel after snoozing the next ad.
+type SnoozeNextAdData struct {
+	SnoozeCount     int    `json:"snooze_count,omitempty"`
+	SnoozeRefreshAt string `json:"snooze_refresh_at,omitempty"`
+	NextAdAt        string `json:"next_ad_at,omitempty"`
+}
+
+// SnoozeNextAdResponse represents a response from a Snooze Next Ad command.
+type SnoozeNextAdResponse struct {
+	Data []SnoozeNextAdData `json:"data,omitempty"`
+}
+
+// SnoozeNextAd pushes back the next scheduled ad on the broadcaster's channel by 5 minutes.
+// Fails if the channel has no snoozes left.
+// Requires scope: channel:manage:ads
+//
+// https://dev.twitch.tv/docs/api/reference#snooze-next-ad
+func (client *Client) SnoozeNextAd(opt *SnoozeNextAdOpt) (*SnoozeNextAdResponse, error) {
+	if client.tokenType != "user" {
+		return nil, errors.New("Helix: Snooze Next Ad endpoint requires a user token for authentication.")
+	}
+	if !client.hasScope("channel:manage:ads") {
+		return nil, errors.New("Helix: Missing required scope for Snooze Next Ad- channel:manage:ads")
+	}
+
+	data := new(SnoozeNextAdResponse)
+	resp, err := client.postRequest(snoozeNextAdPath, opt)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkResponse(resp); err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(resp.Data, data)
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
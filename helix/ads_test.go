@@ -0,0 +1,26 @@
+package helix
+
+import (
+	"net/http"
+	"testing"
+)
+
+// Tests that StartCommercial validates the commercial length and decodes retry_after.
+func TestStartCommercial(t *testing.T) {
+	cfg := &Config{Scopes: []string{"channel:edit:commercial"}}
+	client := newMockClient(cfg, "user", http.StatusOK, []byte(`{"data":[{"length":60,"message":"","retry_after":480}]}`))
+
+	for _, length := range []int{0, 29, 181} {
+		if _, err := client.StartCommercial(&StartCommercialBody{BroadcasterID: "123", Length: length}); err == nil {
+			t.Errorf("expected error for length %d", length)
+		}
+	}
+
+	resp, err := client.StartCommercial(&StartCommercialBody{BroadcasterID: "123", Length: 60})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Data) != 1 || resp.Data[0].RetryAfter != 480 {
+		t.Error("unexpected response:", resp.Data)
+	}
+}
@@ -0,0 +1,326 @@
+package helix
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	getExtensionAnalyticsPath = "/analytics/extensions"
+	getGameAnalyticsPath      = "/analytics/games"
+)
+
+// AnalyticsDateRange represents the period of time covered by an analytics report.
+type AnalyticsDateRange struct {
+	StartedAt time.Time `json:"started_at,omitempty"`
+	EndedAt   time.Time `json:"ended_at,omitempty"`
+}
+
+// GetExtensionAnalyticsOpt defines the options available for Get Extension Analytics.
+// StartedAt and EndedAt must be RFC3339 timestamps and must be provided together.
+type GetExtensionAnalyticsOpt struct {
+	After       string `url:"after,omitempty"`
+	EndedAt     string `url:"ended_at,omitempty"`
+	ExtensionID string `url:"extension_id,omitempty"`
+	First       int    `url:"first,omitempty"`
+	StartedAt   string `url:"started_at,omitempty"`
+	Type        string `url:"type,omitempty"`
+}
+
+// GetExtensionAnalyticsData represents a signed URL to an extension analytics report.
+type GetExtensionAnalyticsData struct {
+	ExtensionID string             `json:"extension_id,omitempty"`
+	URL         string             `json:"URL,omitempty"`
+	Type        string             `json:"type,omitempty"`
+	DateRange   AnalyticsDateRange `json:"date_range,omitempty"`
+}
+
+// GetExtensionAnalyticsResponse represents a response from a Get Extension Analytics command.
+type GetExtensionAnalyticsResponse struct {
+	Data       []GetExtensionAnalyticsData `json:"data,omitempty"`
+	Pagination PaginationData              `json:"pagination,omitempty"`
+}
+
+// GetExtensionAnalytics returns signed URLs to CSV analytics reports for the extensions owned by the user of the access token.
+// The URLs expire after 5 minutes. Use a ReportDownloader to read the reports.
+// Requires scope: analytics:read:extensions
+//
+// https://dev.twitch.tv/docs/api/reference#get-extension-analytics
+func (client *Client) GetExtensionAnalytics(opt *GetExtensionAnalyticsOpt) (*GetExtensionAnalyticsResponse, error) {
+	if client.tokenType != "user" {
+		return nil, errors.New("Helix: Get Extension Analytics endpoint requires a user token for authentication.")
+	}
+	if !client.hasScope("analytics:read:extensions") {
+		return nil, errors.New("Helix: Missing required scope for Get Extension Analytics- analytics:read:extensions")
+	}
+	if opt != nil && (opt.StartedAt == "") != (opt.EndedAt == "") {
+		return nil, errors.New("Helix: Analytics started at and ended at must be provided together.")
+	}
+
+	data := new(GetExtensionAnalyticsResponse)
+	resp, err := client.getRequest(getExtensionAnalyticsPath, opt)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkResponse(resp); err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(resp.Data, data)
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// GetGameAnalyticsOpt defines the options available for Get Game Analytics.
+// StartedAt and EndedAt must be RFC3339 timestamps and must be provided together.
+type GetGameAnalyticsOpt struct {
+	After     string `url:"after,omitempty"`
+	EndedAt   string `url:"ended_at,omitempty"`
+	First     int    `url:"first,omitempty"`
+	GameID    string `url:"game_id,omitempty"`
+	StartedAt string `url:"started_at,omitempty"`
+	Type      string `url:"type,omitempty"`
+}
+
+// GetGameAnalyticsData represents a signed URL to a game analytics report.
+type GetGameAnalyticsData struct {
+	GameID    string             `json:"game_id,omitempty"`
+	URL       string             `json:"URL,omitempty"`
+	Type      string             `json:"type,omitempty"`
+	DateRange AnalyticsDateRange `json:"date_range,omitempty"`
+}
+
+// GetGameAnalyticsResponse represents a response from a Get Game Analytics command.
+type GetGameAnalyticsResponse struct {
+	Data       []GetGameAnalyticsData `json:"data,omitempty"`
+	Pagination PaginationData         `json:"pagination,omitempty"`
+}
+
+// GetGameAnalytics returns signed URLs to CSV analytics reports for the games owned by the user of the access token.
+// The URLs expire after 5 minutes. Use a ReportDownloader to read the reports.
+// Requires scope: analytics:read:games
+//
+// https://dev.twitch.tv/docs/api/reference#get-game-analytics
+func (client *Client) GetGameAnalytics(opt *GetGameAnalyticsOpt) (*GetGameAnalyticsResponse, error) {
+	if client.tokenType != "user" {
+		return nil, errors.New("Helix: Get Game Analytics endpoint requires a user token for authentication.")
+	}
+	if !client.hasScope("analytics:read:games") {
+		return nil, errors.New("Helix: Missing required scope for Get Game Analytics- analytics:read:games")
+	}
+	if opt != nil && (opt.StartedAt == "") != (opt.EndedAt == "") {
+		return nil, errors.New("Helix: Analytics started at and ended at must be provided together.")
+	}
+
+	data := new(GetGameAnalyticsResponse)
+	resp, err := client.getRequest(getGameAnalyticsPath, opt)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkResponse(resp); err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(resp.Data, data)
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// AnalyticsRecord represents a single row of an analytics report.
+// The known columns of extension and game reports are decoded into typed fields, and are left
+// as zero values when the report does not have them. Fields maps each column header of the report
+// to the value in this row, including columns without a typed field.
+type AnalyticsRecord struct {
+	Date time.Time
+
+	// Extension report columns.
+	ExtensionName     string
+	ExtensionClientID string
+	Installs          int
+	Uninstalls        int
+	Activations       int
+	Renders           int
+	Clicks            int
+
+	// Game report columns.
+	GameName       string
+	GameID         string
+	LiveViews      int
+	NonLiveViews   int
+	HoursWatched   float64
+	AverageViewers float64
+
+	// Columns found in both reports.
+	Views                int
+	UniqueViewers        int
+	UniqueActiveChannels int
+
+	Fields map[string]string
+}
+
+// The report columns decoded into the typed fields of an AnalyticsRecord.
+var analyticsStringColumns = map[string]func(*AnalyticsRecord) *string{
+	"Extension Name":      func(r *AnalyticsRecord) *string { return &r.ExtensionName },
+	"Extension Client ID": func(r *AnalyticsRecord) *string { return &r.ExtensionClientID },
+	"Game Name":           func(r *AnalyticsRecord) *string { return &r.GameName },
+	"Game ID":             func(r *AnalyticsRecord) *string { return &r.GameID },
+}
+
+var analyticsIntColumns = map[string]func(*AnalyticsRecord) *int{
+	"Installs":               func(r *AnalyticsRecord) *int { return &r.Installs },
+	"Uninstalls":             func(r *AnalyticsRecord) *int { return &r.Uninstalls },
+	"Activations":            func(r *AnalyticsRecord) *int { return &r.Activations },
+	"Renders":                func(r *AnalyticsRecord) *int { return &r.Renders },
+	"Clicks":                 func(r *AnalyticsRecord) *int { return &r.Clicks },
+	"Live Views":             func(r *AnalyticsRecord) *int { return &r.LiveViews },
+	"Non-Live Views":         func(r *AnalyticsRecord) *int { return &r.NonLiveViews },
+	"Views":                  func(r *AnalyticsRecord) *int { return &r.Views },
+	"Unique Viewers":         func(r *AnalyticsRecord) *int { return &r.UniqueViewers },
+	"Unique Active Channels": func(r *AnalyticsRecord) *int { return &r.UniqueActiveChannels },
+}
+
+var analyticsFloatColumns = map[string]func(*AnalyticsRecord) *float64{
+	"Hours Watched":   func(r *AnalyticsRecord) *float64 { return &r.HoursWatched },
+	"Average Viewers": func(r *AnalyticsRecord) *float64 { return &r.AverageViewers },
+}
+
+// decodeFields fills the typed fields of the record from the known columns in Fields.
+func (r *AnalyticsRecord) decodeFields() error {
+	r.Date = parseReportDate(r.Fields["Date"])
+	for column, field := range analyticsStringColumns {
+		*field(r) = r.Fields[column]
+	}
+	for column, field := range analyticsIntColumns {
+		v, err := r.Int(column)
+		if err != nil {
+			return fmt.Errorf("Helix: Analytics report column %s: %v", column, err)
+		}
+		*field(r) = v
+	}
+	for column, field := range analyticsFloatColumns {
+		v, err := r.Float(column)
+		if err != nil {
+			return fmt.Errorf("Helix: Analytics report column %s: %v", column, err)
+		}
+		*field(r) = v
+	}
+	return nil
+}
+
+// Int returns the value of column as an integer. Empty values are returned as 0.
+func (r *AnalyticsRecord) Int(column string) (int, error) {
+	v := strings.TrimSpace(r.Fields[column])
+	if v == "" {
+		return 0, nil
+	}
+	return strconv.Atoi(v)
+}
+
+// Float returns the value of column as a float. Empty values are returned as 0.
+func (r *AnalyticsRecord) Float(column string) (float64, error) {
+	v := strings.TrimSpace(r.Fields[column])
+	if v == "" {
+		return 0, nil
+	}
+	return strconv.ParseFloat(v, 64)
+}
+
+// ReportDownloader downloads analytics reports from the signed URLs returned by Helix.
+// The signed URLs must not be sent with Twitch credentials, so the downloader uses its own HTTPClient.
+type ReportDownloader struct {
+	conn HTTPClient
+}
+
+// NewReportDownloader returns a ReportDownloader that downloads reports with conn.
+// If conn is nil, http.DefaultClient is used.
+func NewReportDownloader(conn HTTPClient) *ReportDownloader {
+	if conn == nil {
+		conn = http.DefaultClient
+	}
+	return &ReportDownloader{
+		conn: conn,
+	}
+}
+
+// Download streams the CSV report at reportURL, calling handler with each row in order.
+// Downloading stops at the first error returned by handler.
+func (d *ReportDownloader) Download(reportURL string, handler func(*AnalyticsRecord) error) error {
+	request, err := http.NewRequest(http.MethodGet, reportURL, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := d.conn.Do(request)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("Helix: Analytics report download failed with status %d", resp.StatusCode)
+	}
+	return readReport(resp.Body, handler)
+}
+
+// readReport decodes CSV rows from r into AnalyticsRecords using the first row as the column headers.
+func readReport(r io.Reader, handler func(*AnalyticsRecord) error) error {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	// Reports exported from spreadsheets may start with a UTF-8 byte order mark.
+	if len(header) > 0 {
+		header[0] = strings.TrimPrefix(header[0], "\ufeff")
+	}
+
+	for {
+		row, err := reader.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		record := &AnalyticsRecord{
+			Fields: make(map[string]string, len(header)),
+		}
+		for i, column := range header {
+			if i < len(row) {
+				record.Fields[column] = row[i]
+			}
+		}
+		if err := record.decodeFields(); err != nil {
+			return err
+		}
+
+		if err := handler(record); err != nil {
+			return err
+		}
+	}
+}
+
+// parseReportDate parses the Date column of a report, which may be a timestamp or a plain date.
+func parseReportDate(date string) time.Time {
+	for _, layout := range []string{time.RFC3339, "2006-01-02", "01/02/2006"} {
+		if t, err := time.Parse(layout, date); err == nil {
+			return t
+		}
+	}
+	return time.Time{}
+}
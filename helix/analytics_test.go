@@ -0,0 +1,109 @@
+package helix
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// Tests that GetGameAnalytics validates the date range and decodes the report URL.
+func TestGetGameAnalytics(t *testing.T) {
+	cfg := &Config{Scopes: []string{"analytics:read:games"}}
+	client := newMockClient(cfg, "user", http.StatusOK, []byte(`{"data":[{"game_id":"493057","URL":"https://example.com/report.csv","type":"overview_v2","date_range":{"started_at":"2018-01-01T00:00:00Z","ended_at":"2018-03-01T00:00:00Z"}}],"pagination":{}}`))
+
+	resp, err := client.GetGameAnalytics(&GetGameAnalyticsOpt{GameID: "493057"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Data) != 1 || resp.Data[0].URL != "https://example.com/report.csv" {
+		t.Error("unexpected response:", resp.Data)
+	}
+	if !resp.Data[0].DateRange.EndedAt.Equal(time.Date(2018, 3, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Error("unexpected date range:", resp.Data[0].DateRange)
+	}
+
+	if _, err := client.GetGameAnalytics(&GetGameAnalyticsOpt{StartedAt: "2018-01-01T00:00:00Z"}); err == nil {
+		t.Error("expected error for a started at without an ended at")
+	}
+}
+
+// Tests that ReportDownloader streams CSV rows from a server into records.
+func TestReportDownloader(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "" {
+			t.Error("report download must not send credentials")
+		}
+		w.Write([]byte("Date,Game Name,Live Views,Average Viewers\n2018-01-01,Test Game,1200,45.5\n2018-01-02,Test Game,,12\n"))
+	}))
+	defer server.Close()
+
+	var records []*AnalyticsRecord
+	err := NewReportDownloader(server.Client()).Download(server.URL, func(r *AnalyticsRecord) error {
+		records = append(records, r)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 2 {
+		t.Fatalf("wanted 2 records, got %d", len(records))
+	}
+
+	if !records[0].Date.Equal(time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Error("unexpected date:", records[0].Date)
+	}
+	if views, err := records[0].Int("Live Views"); err != nil || views != 1200 {
+		t.Error("unexpected live views:", views, err)
+	}
+	if avg, err := records[0].Float("Average Viewers"); err != nil || avg != 45.5 {
+		t.Error("unexpected average viewers:", avg, err)
+	}
+	if views, err := records[1].Int("Live Views"); err != nil || views != 0 {
+		t.Error("unexpected live views:", views, err)
+	}
+	if records[0].GameName != "Test Game" || records[0].LiveViews != 1200 || records[0].AverageViewers != 45.5 {
+		t.Error("typed fields not decoded:", records[0])
+	}
+
+	// Handler errors stop the download.
+	stop := errors.New("stop")
+	count := 0
+	err = NewReportDownloader(server.Client()).Download(server.URL, func(r *AnalyticsRecord) error {
+		count++
+		return stop
+	})
+	if err != stop || count != 1 {
+		t.Error("expected download to stop after the first record")
+	}
+}
+
+// Tests that typed fields are decoded from extension reports, including reports starting with a
+// byte order mark, and that invalid numbers are reported as errors.
+func TestReadReportTyped(t *testing.T) {
+	report := "\ufeffDate,Extension Name,Extension Client ID,Installs,Uninstalls,Renders,Unique Active Channels\n2018-03-01T00:00:00Z,My Extension,abcd1234,10,2,1500,7\n"
+	var record *AnalyticsRecord
+	err := readReport(strings.NewReader(report), func(r *AnalyticsRecord) error {
+		record = r
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !record.Date.Equal(time.Date(2018, 3, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Error("unexpected date:", record.Date)
+	}
+	if record.ExtensionName != "My Extension" || record.ExtensionClientID != "abcd1234" || record.Installs != 10 ||
+		record.Uninstalls != 2 || record.Renders != 1500 || record.UniqueActiveChannels != 7 {
+		t.Error("typed fields not decoded:", record)
+	}
+
+	err = readReport(strings.NewReader("Date,Installs\n2018-03-01,lots\n"), func(r *AnalyticsRecord) error {
+		return nil
+	})
+	if err == nil {
+		t.Error("expected error for invalid number")
+	}
+}
@@ -0,0 +1,116 @@
+package helix
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+)
+
+const (
+	getContentClassificationLabelsPath = "/content_classification_labels"
+	modifyChannelInformationPath       = "/channels"
+)
+
+// GetContentClassificationLabelsOpt defines the options available for Get Content Classification Labels.
+// Locale selects the language of the names and descriptions, for example en-US.
+type GetContentClassificationLabelsOpt struct {
+	Locale string `url:"locale,omitempty"`
+}
+
+// GetContentClassificationLabelsData represents a content classification label.
+type GetContentClassificationLabelsData struct {
+	ID          string `json:"id,omitempty"`
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// GetContentClassificationLabelsResponse represents a response from a Get Content Classification Labels command.
+type GetContentClassificationLabelsResponse struct {
+	Data []GetContentClassificationLabelsData `json:"data,omitempty"`
+}
+
+// GetContentClassificationLabels returns the content classification labels that can be applied to channels.
+//
+// https://dev.twitch.tv/docs/api/reference#get-content-classification-labels
+func (client *Client) GetContentClassificationLabels(opt *GetContentClassificationLabelsOpt) (*GetContentClassificationLabelsResponse, error) {
+	data := new(GetContentClassificationLabelsResponse)
+	resp, err := client.getRequest(getContentClassificationLabelsPath, opt)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkResponse(resp); err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(resp.Data, data)
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// ModifyChannelInformationOpt defines the options available for Modify Channel Information.
+type ModifyChannelInformationOpt struct {
+	BroadcasterID string `url:"broadcaster_id"`
+}
+
+// ContentClassificationLabelSetting enables or disables a content classification label on a channel.
+type ContentClassificationLabelSetting struct {
+	ID        string `json:"id"`
+	IsEnabled bool   `json:"is_enabled"`
+}
+
+// ModifyChannelInformationBody represents the request body of a Modify Channel Information command.
+// Only the fields that are set are changed. ContentClassificationLabels sets or clears individual labels,
+// and IsBrandedContent is a pointer so that it can be explicitly cleared.
+type ModifyChannelInformationBody struct {
+	GameID                      string                              `json:"game_id,omitempty"`
+	BroadcasterLanguage         string                              `json:"broadcaster_language,omitempty"`
+	Title                       string                              `json:"title,omitempty"`
+	Delay                       *int                                `json:"delay,omitempty"`
+	Tags                        []string                            `json:"tags,omitempty"`
+	ContentClassificationLabels []ContentClassificationLabelSetting `json:"content_classification_labels,omitempty"`
+	IsBrandedContent            *bool                               `json:"is_branded_content,omitempty"`
+}
+
+// ModifyChannelInformation updates the broadcaster's channel properties.
+// The request is not sent if a content classification label is empty, listed more than once or is MatureGame.
+// Unknown label IDs are left to Twitch to reject, see GetContentClassificationLabels for the current labels.
+// Requires scope: channel:manage:broadcast
+//
+// https://dev.twitch.tv/docs/api/reference#modify-channel-information
+func (client *Client) ModifyChannelInformation(opt *ModifyChannelInformationOpt, body *ModifyChannelInformationBody) error {
+	if client.tokenType != "user" {
+		return errors.New("Helix: Modify Channel Information endpoint requires a user token for authentication.")
+	}
+	if !client.hasScope("channel:manage:broadcast") {
+		return errors.New("Helix: Missing required scope for Modify Channel Information- channel:manage:broadcast")
+	}
+	if err := ValidateContentClassificationLabels(body.ContentClassificationLabels); err != nil {
+		return err
+	}
+
+	resp, err := client.jsonRequest(modifyChannelInformationPath, opt, body, http.MethodPatch)
+	if err != nil {
+		return err
+	}
+	return checkResponse(resp)
+}
+
+// ValidateContentClassificationLabels returns an error if any label ID is empty, appears more than once,
+// or is MatureGame, which is applied automatically based on the game and cannot be set.
+func ValidateContentClassificationLabels(labels []ContentClassificationLabelSetting) error {
+	seen := make(map[string]bool, len(labels))
+	for _, l := range labels {
+		if l.ID == "" {
+			return errors.New("Helix: Content classification label requires an ID.")
+		}
+		if l.ID == "MatureGame" {
+			return errors.New("Helix: Content classification label MatureGame is set automatically and cannot be changed.")
+		}
+		if seen[l.ID] {
+			return errors.New("Helix: Content classification label listed more than once: " + l.ID)
+		}
+		seen[l.ID] = true
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package helix
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+// Tests that invalid content classification labels are rejected before a request is sent.
+func TestModifyChannelInformationLabels(t *testing.T) {
+	sent := 0
+	client := &Client{
+		conn: &mockHTTPClient{
+			response: func(w http.ResponseWriter, r *http.Request) {
+				sent++
+				body := make(map[string]interface{})
+				if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+					t.Error(err)
+				}
+				if body["is_branded_content"] != false {
+					t.Error("expected is_branded_content to be explicitly cleared")
+				}
+				w.WriteHeader(http.StatusNoContent)
+			},
+		},
+		config:    &Config{Scopes: []string{"channel:manage:broadcast"}},
+		tokenType: "user",
+	}
+	opt := &ModifyChannelInformationOpt{BroadcasterID: "123"}
+	branded := false
+
+	invalid := [][]ContentClassificationLabelSetting{
+		{{ID: "Gambling", IsEnabled: true}, {ID: "", IsEnabled: true}},
+		{{ID: "MatureGame", IsEnabled: false}},
+		{{ID: "Gambling", IsEnabled: true}, {ID: "Gambling", IsEnabled: false}},
+	}
+	for _, labels := range invalid {
+		if err := client.ModifyChannelInformation(opt, &ModifyChannelInformationBody{ContentClassificationLabels: labels}); err == nil {
+			t.Error("expected error for labels:", labels)
+		}
+	}
+	if sent != 0 {
+		t.Error("request sent with invalid labels")
+	}
+
+	err := client.ModifyChannelInformation(opt, &ModifyChannelInformationBody{
+		ContentClassificationLabels: []ContentClassificationLabelSetting{{ID: "Gambling", IsEnabled: true}, {ID: "ViolentGraphic", IsEnabled: false}},
+		IsBrandedContent:            &branded,
+	})
+	if err != nil {
+		t.Error(err)
+	}
+	if sent != 1 {
+		t.Error("expected request to be sent")
+	}
+}
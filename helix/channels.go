@@ -0,0 +1,284 @@
+package helix
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+const (
+	getChannelFollowersPath  = "/channels/followers"
+	getFollowedChannelsPath  = "/channels/followed"
+	getChannelEditorsPath    = "/channels/editors"
+	getModeratedChannelsPath = "/moderation/channels"
+	getStreamKeyPath         = "/streams/key"
+)
+
+// GetChannelFollowersOpt defines the options available for Get Channel Followers.
+// If UserID is set, the response only contains that user if they follow the broadcaster.
+type GetChannelFollowersOpt struct {
+	BroadcasterID string `url:"broadcaster_id"`
+	UserID        string `url:"user_id,omitempty"`
+	First         int    `url:"first,omitempty"`
+	After         string `url:"after,omitempty"`
+}
+
+// GetChannelFollowersData represents a user that follows a broadcaster.
+type GetChannelFollowersData struct {
+	UserID     string    `json:"user_id,omitempty"`
+	UserLogin  string    `json:"user_login,omitempty"`
+	UserName   string    `json:"user_name,omitempty"`
+	FollowedAt time.Time `json:"followed_at,omitempty"`
+}
+
+// GetChannelFollowersResponse represents a response from a Get Channel Followers command.
+type GetChannelFollowersResponse struct {
+	Total      int                       `json:"total"`
+	Data       []GetChannelFollowersData `json:"data,omitempty"`
+	Pagination PaginationData            `json:"pagination,omitempty"`
+}
+
+// GetChannelFollowers returns a page of the users that follow a broadcaster, most recent first.
+// The user of the access token must be the broadcaster or one of their moderators.
+// Requires scope: moderator:read:followers
+//
+// https://dev.twitch.tv/docs/api/reference#get-channel-followers
+func (client *Client) GetChannelFollowers(opt *GetChannelFollowersOpt) (*GetChannelFollowersResponse, error) {
+	if client.tokenType != "user" {
+		return nil, errors.New("Helix: Get Channel Followers endpoint requires a user token for authentication.")
+	}
+	if !client.hasScope("moderator:read:followers") {
+		return nil, errors.New("Helix: Missing required scope for Get Channel Followers- moderator:read:followers")
+	}
+
+	data := new(GetChannelFollowersResponse)
+	resp, err := client.getRequest(getChannelFollowersPath, opt)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkResponse(resp); err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(resp.Data, data)
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// GetFollowedChannelsOpt defines the options available for Get Followed Channels.
+// If BroadcasterID is set, the response only contains that broadcaster if the user follows them.
+type GetFollowedChannelsOpt struct {
+	UserID        string `url:"user_id"`
+	BroadcasterID string `url:"broadcaster_id,omitempty"`
+	First         int    `url:"first,omitempty"`
+	After         string `url:"after,omitempty"`
+}
+
+// GetFollowedChannelsData represents a broadcaster that a user follows.
+type GetFollowedChannelsData struct {
+	BroadcasterID    string    `json:"broadcaster_id,omitempty"`
+	BroadcasterLogin string    `json:"broadcaster_login,omitempty"`
+	BroadcasterName  string    `json:"broadcaster_name,omitempty"`
+	FollowedAt       time.Time `json:"followed_at,omitempty"`
+}
+
+// GetFollowedChannelsResponse represents a response from a Get Followed Channels command.
+type GetFollowedChannelsResponse struct {
+	Total      int                       `json:"total"`
+	Data       []GetFollowedChannelsData `json:"data,omitempty"`
+	Pagination PaginationData            `json:"pagination,omitempty"`
+}
+
+// GetFollowedChannels returns a page of the broadcasters that a user follows, most recent first.
+// The user of the access token must be the user in opt.
+// Requires scope: user:read:follows
+//
+// https://dev.twitch.tv/docs/api/reference#get-followed-channels
+func (client *Client) GetFollowedChannels(opt *GetFollowedChannelsOpt) (*GetFollowedChannelsResponse, error) {
+	if client.tokenType != "user" {
+		return nil, errors.New("Helix: Get Followed Channels endpoint requires a user token for authentication.")
+	}
+	if !client.hasScope("user:read:follows") {
+		return nil, errors.New("Helix: Missing required scope for Get Followed Channels- user:read:follows")
+	}
+
+	data := new(GetFollowedChannelsResponse)
+	resp, err := client.getRequest(getFollowedChannelsPath, opt)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkResponse(resp); err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(resp.Data, data)
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// GetChannelEditorsOpt defines the options available for Get Channel Editors.
+type GetChannelEditorsOpt struct {
+	BroadcasterID string `url:"broadcaster_id"`
+}
+
+// GetChannelEditorsData represents a user that is an editor of a broadcaster's channel.
+type GetChannelEditorsData struct {
+	UserID    string    `json:"user_id,omitempty"`
+	UserName  string    `json:"user_name,omitempty"`
+	CreatedAt time.Time `json:"created_at,omitempty"`
+}
+
+// GetChannelEditorsResponse represents a response from a Get Channel Editors command.
+type GetChannelEditorsResponse struct {
+	Data []GetChannelEditorsData `json:"data,omitempty"`
+}
+
+// GetChannelEditors returns the users that are editors of the broadcaster's channel.
+// Requires scope: channel:read:editors
+//
+// https://dev.twitch.tv/docs/api/reference#get-channel-editors
+func (client *Client) GetChannelEditors(opt *GetChannelEditorsOpt) (*GetChannelEditorsResponse, error) {
+	if client.tokenType != "user" {
+		return nil, errors.New("Helix: Get Channel Editors endpoint requires a user token for authentication.")
+	}
+	if !client.hasScope("channel:read:editors") {
+		return nil, errors.New("Helix: Missing required scope for Get Channel Editors- channel:read:editors")
+	}
+
+	data := new(GetChannelEditorsResponse)
+	resp, err := client.getRequest(getChannelEditorsPath, opt)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkResponse(resp); err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(resp.Data, data)
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// GetModeratedChannelsOpt defines the options available for Get Moderated Channels.
+// UserID must match the user of the access token.
+type GetModeratedChannelsOpt struct {
+	UserID string `url:"user_id"`
+	First  int    `url:"first,omitempty"`
+	After  string `url:"after,omitempty"`
+}
+
+// GetModeratedChannelsData represents a channel that a user moderates.
+type GetModeratedChannelsData struct {
+	BroadcasterID    string `json:"broadcaster_id,omitempty"`
+	BroadcasterLogin string `json:"broadcaster_login,omitempty"`
+	BroadcasterName  string `json:"broadcaster_name,omitempty"`
+}
+
+// GetModeratedChannelsResponse represents a response from a Get Moderated Channels command.
+type GetModeratedChannelsResponse struct {
+	Data       []GetModeratedChannelsData `json:"data,omitempty"`
+	Pagination PaginationData             `json:"pagination,omitempty"`
+}
+
+// GetModeratedChannels returns a page of the channels that the user has moderator privileges in.
+// Requires scope: user:read:moderated_channels
+//
+// https://dev.twitch.tv/docs/api/reference#get-moderated-channels
+func (client *Client) GetModeratedChannels(opt *GetModeratedChannelsOpt) (*GetModeratedChannelsResponse, error) {
+	if client.tokenType != "user" {
+		return nil, errors.New("Helix: Get Moderated Channels endpoint requires a user token for authentication.")
+	}
+	if !client.hasScope("user:read:moderated_channels") {
+		return nil, errors.New("Helix: Missing required scope for Get Moderated Channels- user:read:moderated_channels")
+	}
+
+	data := new(GetModeratedChannelsResponse)
+	resp, err := client.getRequest(getModeratedChannelsPath, opt)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkResponse(resp); err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(resp.Data, data)
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// StreamKey is a channel's secret stream key. It is redacted when formatted with the fmt package
+// or encoded as JSON, so it is not leaked by logging. Use Value to obtain the key itself.
+type StreamKey string
+
+// Value returns the unredacted stream key.
+func (k StreamKey) Value() string {
+	return string(k)
+}
+
+// String returns a redacted placeholder instead of the stream key.
+func (k StreamKey) String() string {
+	return "[REDACTED]"
+}
+
+// GoString returns a redacted placeholder instead of the stream key.
+func (k StreamKey) GoString() string {
+	return k.String()
+}
+
+// Format writes a redacted placeholder for every formatting verb.
+func (k StreamKey) Format(f fmt.State, verb rune) {
+	f.Write([]byte(k.String()))
+}
+
+// MarshalJSON encodes a redacted placeholder instead of the stream key.
+func (k StreamKey) MarshalJSON() ([]byte, error) {
+	return json.Marshal(k.String())
+}
+
+// GetStreamKeyOpt defines the options available for Get Stream Key.
+type GetStreamKeyOpt struct {
+	BroadcasterID string `url:"broadcaster_id"`
+}
+
+// GetStreamKeyData represents a channel's stream key.
+type GetStreamKeyData struct {
+	StreamKey StreamKey `json:"stream_key,omitempty"`
+}
+
+// GetStreamKeyResponse represents a response from a Get Stream Key command.
+type GetStreamKeyResponse struct {
+	Data []GetStreamKeyData `json:"data,omitempty"`
+}
+
+// GetStreamKey returns the stream key of the broadcaster's channel.
+// The key is returned as a StreamKey, which is redacted when printed.
+// Requires scope: channel:read:stream_key
+//
+// https://dev.twitch.tv/docs/api/reference#get-stream-key
+func (client *Client) GetStreamKey(opt *GetStreamKeyOpt) (*GetStreamKeyResponse, error) {
+	if client.tokenType != "user" {
+		return nil, errors.New("Helix: Get Stream Key endpoint requires a user token for authentication.")
+	}
+	if !client.hasScope("channel:read:stream_key") {
+		return nil, errors.New("Helix: Missing required scope for Get Stream Key- channel:read:stream_key")
+	}
+
+	data := new(GetStreamKeyResponse)
+	resp, err := client.getRequest(getStreamKeyPath, opt)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkResponse(resp); err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(resp.Data, data)
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
@@ -0,0 +1,79 @@
+package helix
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+// Tests that GetChannelFollowers requires its scope and decodes the follow time and total.
+func TestGetChannelFollowers(t *testing.T) {
+	body := []byte(`{"total":8,"data":[{"user_id":"11111","user_name":"UserDisplayName","user_login":"userloginname","followed_at":"2022-05-24T22:22:08Z"}],"pagination":{"cursor":"eyJiIjpudWxsLCJhIjp7Ik9mZnNldCI6NX19"}}`)
+
+	if _, err := newMockClient(new(Config), "user", http.StatusOK, body).GetChannelFollowers(&GetChannelFollowersOpt{BroadcasterID: "123"}); err == nil {
+		t.Error("expected error for missing scope")
+	}
+
+	client := newMockClient(&Config{Scopes: []string{"moderator:read:followers"}}, "user", http.StatusOK, body)
+	resp, err := client.GetChannelFollowers(&GetChannelFollowersOpt{BroadcasterID: "123"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Total != 8 || len(resp.Data) != 1 || resp.Data[0].UserID != "11111" {
+		t.Error("unexpected response:", resp)
+	}
+	if !resp.Data[0].FollowedAt.Equal(time.Date(2022, 5, 24, 22, 22, 8, 0, time.UTC)) {
+		t.Error("unexpected followed at:", resp.Data[0].FollowedAt)
+	}
+	if resp.Pagination.Cursor == "" {
+		t.Error("expected pagination cursor")
+	}
+}
+
+// Tests that stream keys are decoded but redacted when formatted.
+func TestGetStreamKeyRedacted(t *testing.T) {
+	cfg := &Config{Scopes: []string{"channel:read:stream_key"}}
+	client := newMockClient(cfg, "user", http.StatusOK, []byte(`{"data":[{"stream_key":"live_44322889_a34ub37c8ajv98a0"}]}`))
+
+	resp, err := client.GetStreamKey(&GetStreamKeyOpt{BroadcasterID: "123"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	key := resp.Data[0].StreamKey
+	if key.Value() != "live_44322889_a34ub37c8ajv98a0" {
+		t.Error("unexpected stream key value")
+	}
+
+	for _, format := range []string{"%s", "%v", "%+v", "%#v", "%q", "%x"} {
+		for _, v := range []interface{}{key, resp.Data[0], resp} {
+			if out := fmt.Sprintf(format, v); strings.Contains(out, "a34ub37c8ajv98a0") {
+				t.Errorf("stream key leaked with %s: %s", format, out)
+			}
+		}
+	}
+}
+
+// Tests that stream keys are redacted when encoded as JSON but still decoded from responses.
+func TestStreamKeyJSONRedacted(t *testing.T) {
+	data := GetStreamKeyData{StreamKey: "live_44322889_a34ub37c8ajv98a0"}
+	for _, v := range []interface{}{data.StreamKey, data, &GetStreamKeyResponse{Data: []GetStreamKeyData{data}}} {
+		out, err := json.Marshal(v)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if strings.Contains(string(out), "a34ub37c8ajv98a0") {
+			t.Error("stream key leaked in JSON:", string(out))
+		}
+	}
+
+	out, _ := json.Marshal(data)
+	if string(out) != `{"stream_key":"[REDACTED]"}` {
+		t.Error("unexpected JSON:", string(out))
+	}
+	if data.StreamKey.Value() != "live_44322889_a34ub37c8ajv98a0" {
+		t.Error("marshaling changed the stream key value")
+	}
+}
@@ -0,0 +1,202 @@
+package helix
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+const (
+	getCharityCampaignPath  = "/charity/campaigns"
+	getCharityDonationsPath = "/charity/donations"
+	getCreatorGoalsPath     = "/goals"
+)
+
+// CharityAmount represents an exact monetary amount. Value is in the currency's minor units,
+// so a Value of 550 with 2 DecimalPlaces is 5.50.
+type CharityAmount struct {
+	Value         int64  `json:"value"`
+	DecimalPlaces int    `json:"decimal_places"`
+	Currency      string `json:"currency,omitempty"`
+}
+
+// Rat returns the amount as an exact rational number.
+func (a CharityAmount) Rat() *big.Rat {
+	denom := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(a.DecimalPlaces)), nil)
+	return new(big.Rat).SetFrac(big.NewInt(a.Value), denom)
+}
+
+// String returns the amount as a decimal string without rounding, for example 5.50.
+func (a CharityAmount) String() string {
+	if a.DecimalPlaces <= 0 {
+		return strconv.FormatInt(a.Value, 10)
+	}
+
+	sign := ""
+	value := a.Value
+	if value < 0 {
+		sign = "-"
+		value = -value
+	}
+	digits := strconv.FormatInt(value, 10)
+	if len(digits) <= a.DecimalPlaces {
+		digits = strings.Repeat("0", a.DecimalPlaces-len(digits)+1) + digits
+	}
+	point := len(digits) - a.DecimalPlaces
+	return sign + digits[:point] + "." + digits[point:]
+}
+
+// GetCharityCampaignOpt defines the options available for Get Charity Campaign.
+type GetCharityCampaignOpt struct {
+	BroadcasterID string `url:"broadcaster_id"`
+}
+
+// GetCharityCampaignData represents a charity campaign that a broadcaster is running.
+type GetCharityCampaignData struct {
+	ID                 string        `json:"id,omitempty"`
+	BroadcasterID      string        `json:"broadcaster_id,omitempty"`
+	BroadcasterLogin   string        `json:"broadcaster_login,omitempty"`
+	BroadcasterName    string        `json:"broadcaster_name,omitempty"`
+	CharityName        string        `json:"charity_name,omitempty"`
+	CharityDescription string        `json:"charity_description,omitempty"`
+	CharityLogo        string        `json:"charity_logo,omitempty"`
+	CharityWebsite     string        `json:"charity_website,omitempty"`
+	CurrentAmount      CharityAmount `json:"current_amount"`
+	TargetAmount       CharityAmount `json:"target_amount"`
+}
+
+// GetCharityCampaignResponse represents a response from a Get Charity Campaign command.
+// Data is empty if the broadcaster is not running a campaign.
+type GetCharityCampaignResponse struct {
+	Data []GetCharityCampaignData `json:"data,omitempty"`
+}
+
+// GetCharityCampaign returns the charity campaign the broadcaster is currently running.
+// Requires scope: channel:read:charity
+//
+// https://dev.twitch.tv/docs/api/reference#get-charity-campaign
+func (client *Client) GetCharityCampaign(opt *GetCharityCampaignOpt) (*GetCharityCampaignResponse, error) {
+	if client.tokenType != "user" {
+		return nil, errors.New("Helix: Get Charity Campaign endpoint requires a user token for authentication.")
+	}
+	if !client.hasScope("channel:read:charity") {
+		return nil, errors.New("Helix: Missing required scope for Get Charity Campaign- channel:read:charity")
+	}
+
+	data := new(GetCharityCampaignResponse)
+	resp, err := client.getRequest(getCharityCampaignPath, opt)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkResponse(resp); err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(resp.Data, data)
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// GetCharityDonationsOpt defines the options available for Get Charity Campaign Donations.
+type GetCharityDonationsOpt struct {
+	BroadcasterID string `url:"broadcaster_id"`
+	First         int    `url:"first,omitempty"`
+	After         string `url:"after,omitempty"`
+}
+
+// GetCharityDonationsData represents a donation to a charity campaign.
+type GetCharityDonationsData struct {
+	ID         string        `json:"id,omitempty"`
+	CampaignID string        `json:"campaign_id,omitempty"`
+	UserID     string        `json:"user_id,omitempty"`
+	UserLogin  string        `json:"user_login,omitempty"`
+	UserName   string        `json:"user_name,omitempty"`
+	Amount     CharityAmount `json:"amount"`
+}
+
+// GetCharityDonationsResponse represents a response from a Get Charity Campaign Donations command.
+type GetCharityDonationsResponse struct {
+	Data       []GetCharityDonationsData `json:"data,omitempty"`
+	Pagination PaginationData            `json:"pagination,omitempty"`
+}
+
+// GetCharityDonations returns a page of the donations made to the broadcaster's active charity campaign.
+// Requires scope: channel:read:charity
+//
+// https://dev.twitch.tv/docs/api/reference#get-charity-campaign-donations
+func (client *Client) GetCharityDonations(opt *GetCharityDonationsOpt) (*GetCharityDonationsResponse, error) {
+	if client.tokenType != "user" {
+		return nil, errors.New("Helix: Get Charity Campaign Donations endpoint requires a user token for authentication.")
+	}
+	if !client.hasScope("channel:read:charity") {
+		return nil, errors.New("Helix: Missing required scope for Get Charity Campaign Donations- channel:read:charity")
+	}
+
+	data := new(GetCharityDonationsResponse)
+	resp, err := client.getRequest(getCharityDonationsPath, opt)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkResponse(resp); err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(resp.Data, data)
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// GetCreatorGoalsOpt defines the options available for Get Creator Goals.
+type GetCreatorGoalsOpt struct {
+	BroadcasterID string `url:"broadcaster_id"`
+}
+
+// GetCreatorGoalsData represents a goal that a broadcaster has set.
+// Type is one of follower, subscription, subscription_count, new_subscription or new_subscription_count.
+type GetCreatorGoalsData struct {
+	ID               string `json:"id,omitempty"`
+	BroadcasterID    string `json:"broadcaster_id,omitempty"`
+	BroadcasterLogin string `json:"broadcaster_login,omitempty"`
+	BroadcasterName  string `json:"broadcaster_name,omitempty"`
+	Type             string `json:"type,omitempty"`
+	Description      string `json:"description,omitempty"`
+	CurrentAmount    int    `json:"current_amount"`
+	TargetAmount     int    `json:"target_amount"`
+	CreatedAt        string `json:"created_at,omitempty"`
+}
+
+// GetCreatorGoalsResponse represents a response from a Get Creator Goals command.
+type GetCreatorGoalsResponse struct {
+	Data []GetCreatorGoalsData `json:"data,omitempty"`
+}
+
+// GetCreatorGoals returns the broadcaster's active goals.
+// Requires scope: channel:read:goals
+//
+// https://dev.twitch.tv/docs/api/reference#get-creator-goals
+func (client *Client) GetCreatorGoals(opt *GetCreatorGoalsOpt) (*GetCreatorGoalsResponse, error) {
+	if client.tokenType != "user" {
+		return nil, errors.New("Helix: Get Creator Goals endpoint requires a user token for authentication.")
+	}
+	if !client.hasScope("channel:read:goals") {
+		return nil, errors.New("Helix: Missing required scope for Get Creator Goals- channel:read:goals")
+	}
+
+	data := new(GetCreatorGoalsResponse)
+	resp, err := client.getRequest(getCreatorGoalsPath, opt)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkResponse(resp); err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(resp.Data, data)
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
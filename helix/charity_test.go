@@ -0,0 +1,49 @@
+package helix
+
+import (
+	"math/big"
+	"net/http"
+	"testing"
+)
+
+// Tests that charity amounts are formatted exactly.
+func TestCharityAmountString(t *testing.T) {
+	cases := []struct {
+		amount   CharityAmount
+		expected string
+	}{
+		{CharityAmount{Value: 550, DecimalPlaces: 2}, "5.50"},
+		{CharityAmount{Value: 5, DecimalPlaces: 2}, "0.05"},
+		{CharityAmount{Value: 1500000, DecimalPlaces: 2}, "15000.00"},
+		{CharityAmount{Value: 1000, DecimalPlaces: 0}, "1000"},
+		{CharityAmount{Value: -125, DecimalPlaces: 3}, "-0.125"},
+	}
+
+	for _, c := range cases {
+		if got := c.amount.String(); got != c.expected {
+			t.Errorf("wanted: %s\n got: %s\n", c.expected, got)
+		}
+	}
+
+	amount := CharityAmount{Value: 550, DecimalPlaces: 2}
+	if amount.Rat().Cmp(big.NewRat(11, 2)) != 0 {
+		t.Error("expected 5.50 to equal 11/2")
+	}
+}
+
+// Tests that GetCharityCampaign decodes the current and target amounts.
+func TestGetCharityCampaign(t *testing.T) {
+	cfg := &Config{Scopes: []string{"channel:read:charity"}}
+	client := newMockClient(cfg, "user", http.StatusOK, []byte(`{"data":[{"id":"123-abc","broadcaster_id":"123","charity_name":"Example","current_amount":{"value":86000,"decimal_places":2,"currency":"USD"},"target_amount":{"value":1500000,"decimal_places":2,"currency":"USD"}}]}`))
+
+	resp, err := client.GetCharityCampaign(&GetCharityCampaignOpt{BroadcasterID: "123"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Data) != 1 {
+		t.Fatal("expected single campaign")
+	}
+	if resp.Data[0].CurrentAmount.String() != "860.00" || resp.Data[0].TargetAmount.Currency != "USD" {
+		t.Error("unexpected amounts:", resp.Data[0].CurrentAmount, resp.Data[0].TargetAmount)
+	}
+}
@@ -0,0 +1,223 @@
+package helix
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"regexp"
+	"unicode/utf8"
+)
+
+const (
+	getChattersPath     = "/chat/chatters"
+	chatColorPath       = "/chat/color"
+	sendChatMessagePath = "/chat/messages"
+
+	// ChatMessageMaxLength is the maximum length of a chat message.
+	ChatMessageMaxLength = 500
+)
+
+// Named chat colors that any user may set. Turbo and Prime users may also use hex colors.
+var chatColors = map[string]bool{
+	"blue":         true,
+	"blue_violet":  true,
+	"cadet_blue":   true,
+	"chocolate":    true,
+	"coral":        true,
+	"dodger_blue":  true,
+	"firebrick":    true,
+	"golden_rod":   true,
+	"green":        true,
+	"hot_pink":     true,
+	"orange_red":   true,
+	"red":          true,
+	"sea_green":    true,
+	"spring_green": true,
+	"yellow_green": true,
+}
+
+var hexColor = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+
+// GetChattersOpt defines the options available for Get Chatters.
+// ModeratorID must match the user of the access token.
+type GetChattersOpt struct {
+	BroadcasterID string `url:"broadcaster_id"`
+	ModeratorID   string `url:"moderator_id"`
+	First         int    `url:"first,omitempty"`
+	After         string `url:"after,omitempty"`
+}
+
+// GetChattersData represents a user connected to a broadcaster's chat.
+type GetChattersData struct {
+	UserID    string `json:"user_id,omitempty"`
+	UserLogin string `json:"user_login,omitempty"`
+	UserName  string `json:"user_name,omitempty"`
+}
+
+// GetChattersResponse represents a response from a Get Chatters command.
+type GetChattersResponse struct {
+	Data       []GetChattersData `json:"data,omitempty"`
+	Pagination PaginationData    `json:"pagination,omitempty"`
+	Total      int               `json:"total"`
+}
+
+// GetChatters returns a page of the users connected to the broadcaster's chat. Up to 1000 users may be requested per page.
+// Requires scope: moderator:read:chatters
+//
+// https://dev.twitch.tv/docs/api/reference#get-chatters
+func (client *Client) GetChatters(opt *GetChattersOpt) (*GetChattersResponse, error) {
+	if client.tokenType != "user" {
+		return nil, errors.New("Helix: Get Chatters endpoint requires a user token for authentication.")
+	}
+	if !client.hasScope("moderator:read:chatters") {
+		return nil, errors.New("Helix: Missing required scope for Get Chatters- moderator:read:chatters")
+	}
+	if opt.First > 1000 {
+		return nil, errors.New("Helix: Cannot request more than 1000 chatters per call.")
+	}
+
+	data := new(GetChattersResponse)
+	resp, err := client.getRequest(getChattersPath, opt)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkResponse(resp); err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(resp.Data, data)
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// GetUserChatColorOpt defines the options available for Get User Chat Color.
+type GetUserChatColorOpt struct {
+	UserID []string `url:"user_id"`
+}
+
+// GetUserChatColorData represents the color a user's name is shown in chat.
+// Color is a hex color code, or empty if the user has never set a color.
+type GetUserChatColorData struct {
+	UserID    string `json:"user_id,omitempty"`
+	UserLogin string `json:"user_login,omitempty"`
+	UserName  string `json:"user_name,omitempty"`
+	Color     string `json:"color,omitempty"`
+}
+
+// GetUserChatColorResponse represents a response from a Get User Chat Color command.
+type GetUserChatColorResponse struct {
+	Data []GetUserChatColorData `json:"data,omitempty"`
+}
+
+// GetUserChatColor returns the chat colors of up to 100 users.
+//
+// https://dev.twitch.tv/docs/api/reference#get-user-chat-color
+func (client *Client) GetUserChatColor(opt *GetUserChatColorOpt) (*GetUserChatColorResponse, error) {
+	if err := checkQueryValues("user_id", opt.UserID); err != nil {
+		return nil, err
+	}
+
+	data := new(GetUserChatColorResponse)
+	resp, err := client.getRequest(chatColorPath, opt)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkResponse(resp); err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(resp.Data, data)
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// UpdateUserChatColorOpt defines the options available for Update User Chat Color.
+// Color is a named color such as blue_violet, or a hex color code such as #9146FF for Turbo and Prime users.
+type UpdateUserChatColorOpt struct {
+	UserID string `url:"user_id"`
+	Color  string `url:"color"`
+}
+
+// UpdateUserChatColor changes the color of the user's name in chat.
+// Requires scope: user:manage:chat_color
+//
+// https://dev.twitch.tv/docs/api/reference#update-user-chat-color
+func (client *Client) UpdateUserChatColor(opt *UpdateUserChatColorOpt) error {
+	if client.tokenType != "user" {
+		return errors.New("Helix: Update User Chat Color endpoint requires a user token for authentication.")
+	}
+	if !client.hasScope("user:manage:chat_color") {
+		return errors.New("Helix: Missing required scope for Update User Chat Color- user:manage:chat_color")
+	}
+	if !chatColors[opt.Color] && !hexColor.MatchString(opt.Color) {
+		return errors.New("Helix: Chat color must be a named color or a hex color code.")
+	}
+
+	resp, err := client.putRequest(chatColorPath, opt)
+	if err != nil {
+		return err
+	}
+	return checkResponse(resp)
+}
+
+// SendChatMessageBody represents the request body of a Send Chat Message command.
+// SenderID must match the user of the access token. ReplyParentMessageID is optional and
+// makes the message a reply to another message.
+type SendChatMessageBody struct {
+	BroadcasterID        string `json:"broadcaster_id"`
+	SenderID             string `json:"sender_id"`
+	Message              string `json:"message"`
+	ReplyParentMessageID string `json:"reply_parent_message_id,omitempty"`
+}
+
+// ChatDropReason represents why a chat message was not sent.
+type ChatDropReason struct {
+	Code    string `json:"code,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// SendChatMessageData represents the result of sending a chat message.
+// DropReason is set when IsSent is false.
+type SendChatMessageData struct {
+	MessageID  string          `json:"message_id,omitempty"`
+	IsSent     bool            `json:"is_sent"`
+	DropReason *ChatDropReason `json:"drop_reason,omitempty"`
+}
+
+// SendChatMessageResponse represents a response from a Send Chat Message command.
+type SendChatMessageResponse struct {
+	Data []SendChatMessageData `json:"data,omitempty"`
+}
+
+// SendChatMessage sends a message to the broadcaster's chat. Messages longer than 500 characters are rejected
+// before being sent. A message may be accepted but not sent, in which case IsSent is false and DropReason is set.
+// Requires scope: user:write:chat when using a user token. App tokens require the sender to have authorized the user:bot scope.
+//
+// https://dev.twitch.tv/docs/api/reference#send-chat-message
+func (client *Client) SendChatMessage(body *SendChatMessageBody) (*SendChatMessageResponse, error) {
+	if client.tokenType == "user" && !client.hasScope("user:write:chat") {
+		return nil, errors.New("Helix: Missing required scope for Send Chat Message- user:write:chat")
+	}
+	if body.Message == "" {
+		return nil, errors.New("Helix: Cannot send an empty chat message.")
+	}
+	if utf8.RuneCountInString(body.Message) > ChatMessageMaxLength {
+		return nil, errors.New("Helix: Chat message exceeds the maximum length of 500 characters.")
+	}
+
+	data := new(SendChatMessageResponse)
+	resp, err := client.jsonRequest(sendChatMessagePath, nil, body, http.MethodPost)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkResponse(resp); err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(resp.Data, data)
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
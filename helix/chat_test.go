@@ -0,0 +1,42 @@
+package helix
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// Tests that SendChatMessage validates the message length and decodes drop reasons.
+func TestSendChatMessage(t *testing.T) {
+	cfg := &Config{Scopes: []string{"user:write:chat"}}
+	client := newMockClient(cfg, "user", http.StatusOK, []byte(`{"data":[{"message_id":"","is_sent":false,"drop_reason":{"code":"msg_duplicate","message":"Your message is identical to the one you sent within the last 30 seconds."}}]}`))
+
+	resp, err := client.SendChatMessage(&SendChatMessageBody{BroadcasterID: "123", SenderID: "456", Message: "hello"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Data) != 1 || resp.Data[0].IsSent || resp.Data[0].DropReason == nil || resp.Data[0].DropReason.Code != "msg_duplicate" {
+		t.Error("unexpected response:", resp.Data)
+	}
+
+	if _, err := client.SendChatMessage(&SendChatMessageBody{BroadcasterID: "123", SenderID: "456", Message: strings.Repeat("a", ChatMessageMaxLength+1)}); err == nil {
+		t.Error("expected error for message over the maximum length")
+	}
+}
+
+// Tests that UpdateUserChatColor only accepts named and hex colors.
+func TestUpdateUserChatColor(t *testing.T) {
+	cfg := &Config{Scopes: []string{"user:manage:chat_color"}}
+	client := newMockClient(cfg, "user", http.StatusNoContent, nil)
+
+	for _, color := range []string{"blue_violet", "#9146FF"} {
+		if err := client.UpdateUserChatColor(&UpdateUserChatColorOpt{UserID: "123", Color: color}); err != nil {
+			t.Error(err)
+		}
+	}
+	for _, color := range []string{"purple", "#12345", "9146FF"} {
+		if err := client.UpdateUserChatColor(&UpdateUserChatColorOpt{UserID: "123", Color: color}); err == nil {
+			t.Errorf("expected error for color %s", color)
+		}
+	}
+}
@@ -2,12 +2,16 @@
 package helix
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"github.com/google/go-querystring/query"
 	"golang.org/x/oauth2"
 	"golang.org/x/oauth2/clientcredentials"
 	"golang.org/x/oauth2/twitch"
+	"io"
 	"io/ioutil"
 	"net/http"
 	"net/url"
@@ -15,6 +19,9 @@ import (
 
 const (
 	helixRootURL = "https://api.twitch.tv/helix"
+
+	// MaxQueryValues is the maximum number of values accepted by a repeated query parameter such as id or user_id.
+	MaxQueryValues = 100
 )
 
 // HTTPClient interface for mocking purposes
@@ -29,6 +36,17 @@ type Response struct {
 	Data   []byte
 }
 
+// APIError represents an error response returned by the Helix API.
+type APIError struct {
+	ErrorType string `json:"error,omitempty"`
+	Status    int    `json:"status,omitempty"`
+	Message   string `json:"message,omitempty"`
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("Helix: %d %s: %s", e.Status, e.ErrorType, e.Message)
+}
+
 // Client handles communication with the Twitch Helix API.
 type Client struct {
 	conn      HTTPClient
@@ -155,14 +173,60 @@ func (c *Client) postRequest(path string, params interface{}) (*Response, error)
 	return c.sendRequest(request)
 }
 
+// Wrapper for a HTTP PATCH request
+func (c *Client) patchRequest(path string, params interface{}) (*Response, error) {
+	request, err := c.buildRequest(path, params, http.MethodPatch)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendRequest(request)
+}
+
+// Wrapper for a HTTP DELETE request
+func (c *Client) deleteRequest(path string, params interface{}) (*Response, error) {
+	request, err := c.buildRequest(path, params, http.MethodDelete)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendRequest(request)
+}
+
+// Wrapper for a HTTP request with a JSON encoded body
+func (c *Client) jsonRequest(path string, params interface{}, body interface{}, requestType string) (*Response, error) {
+	request, err := c.buildJSONRequest(path, params, body, requestType)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendRequest(request)
+}
+
 // Create an HTTP request.
 func (c *Client) buildRequest(path string, params interface{}, requestType string) (*http.Request, error) {
+	return c.newRequest(path, params, nil, requestType)
+}
+
+// Create an HTTP request with body encoded as JSON.
+func (c *Client) buildJSONRequest(path string, params interface{}, body interface{}, requestType string) (*http.Request, error) {
+	encoded, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := c.newRequest(path, params, bytes.NewReader(encoded), requestType)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	return request, nil
+}
+
+func (c *Client) newRequest(path string, params interface{}, body io.Reader, requestType string) (*http.Request, error) {
 	targetURL, err := buildURL(path, params)
 	if err != nil {
 		return nil, err
 	}
 
-	request, err := http.NewRequest(requestType, targetURL, nil)
+	request, err := http.NewRequest(requestType, targetURL, body)
 	if err != nil {
 		return nil, err
 	}
@@ -189,3 +253,27 @@ func (c *Client) sendRequest(request *http.Request) (*Response, error) {
 	}
 	return response, nil
 }
+
+// checkResponse returns an APIError if the response does not have a 2xx status code.
+func checkResponse(resp *Response) error {
+	if resp.Status >= 200 && resp.Status < 300 {
+		return nil
+	}
+
+	// The body may not be an error object, so fall back to the status code alone.
+	apiErr := new(APIError)
+	json.Unmarshal(resp.Data, apiErr)
+	apiErr.Status = resp.Status
+	if apiErr.ErrorType == "" {
+		apiErr.ErrorType = http.StatusText(resp.Status)
+	}
+	return apiErr
+}
+
+// checkQueryValues returns an error if a repeated query parameter has more values than the API accepts.
+func checkQueryValues(name string, values []string) error {
+	if len(values) > MaxQueryValues {
+		return fmt.Errorf("Helix: Cannot request more than %d %s values per call.", MaxQueryValues, name)
+	}
+	return nil
+}
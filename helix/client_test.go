@@ -73,6 +73,17 @@ func TestBuildRequest(t *testing.T) {
 			expectedURL:      "https://api.twitch.tv/helix/users?login=kyrotobi&login=kyrotobi&login=kyrotobi&login=kyrotobi&login=kyrotobi&login=kyrotobi",
 			expectedMethod:   http.MethodGet,
 		},
+		{
+			inputOpts: &GetStreamsOpt{
+				GameID:   []string{"33214", "509658"},
+				Language: []string{"en", "de"},
+				UserID:   []string{"123"},
+			},
+			inputBaseURL:     getStreamsPath,
+			expectedClientID: "test-client-id",
+			expectedURL:      "https://api.twitch.tv/helix/streams?game_id=33214&game_id=509658&language=en&language=de&user_id=123",
+			expectedMethod:   http.MethodGet,
+		},
 	}
 
 	for _, c := range cases {
@@ -10,13 +10,13 @@ const (
 
 // GetClipsOpt defines the options available for Get Clips.
 type GetClipsOpt struct {
-	ID            string `url:"id,omitempty"`
-	BroadcasterID string `url:"broadcaster_id,omitempty"`
-	GameID        string `url:"game_id,omitempty"`
-	After         string `url:"after,omitempty"`
-	First         int    `url:"first,omitempty"`
-	StartedAt     string `url:"started_at,omitempty"`
-	EndedAt       string `url:"ended_at,omitempty"`
+	ID            []string `url:"id,omitempty"`
+	BroadcasterID string   `url:"broadcaster_id,omitempty"`
+	GameID        string   `url:"game_id,omitempty"`
+	After         string   `url:"after,omitempty"`
+	First         int      `url:"first,omitempty"`
+	StartedAt     string   `url:"started_at,omitempty"`
+	EndedAt       string   `url:"ended_at,omitempty"`
 }
 
 // GetClipsData represents metadata about a clip.
@@ -44,9 +44,16 @@ type GetClipsResponse struct {
 }
 
 // GetClips gets information by clip id, broadcaster id or game id.
+// Up to 100 clip IDs may be provided.
 //
 // https://dev.twitch.tv/docs/api/reference/#get-clips
 func (client *Client) GetClips(opt *GetClipsOpt) (*GetClipsResponse, error) {
+	if opt != nil {
+		if err := checkQueryValues("id", opt.ID); err != nil {
+			return nil, err
+		}
+	}
+
 	data := new(GetClipsResponse)
 	resp, err := client.getRequest(getClipsPath, opt)
 	if err != nil {
@@ -0,0 +1,228 @@
+package helix
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+)
+
+const (
+	conduitsPath      = "/eventsub/conduits"
+	conduitShardsPath = "/eventsub/conduits/shards"
+
+	// MaxConduitShards is the maximum number of shards a conduit may have.
+	MaxConduitShards = 20000
+)
+
+// ConduitData represents a conduit, which distributes the notifications of its subscriptions across its shards.
+type ConduitData struct {
+	ID         string `json:"id"`
+	ShardCount int    `json:"shard_count"`
+}
+
+// ConduitsResponse represents a response from a Get, Create or Update Conduits command.
+type ConduitsResponse struct {
+	Data []ConduitData `json:"data,omitempty"`
+}
+
+// GetConduits returns the conduits created by the client.
+// Requires an app token.
+//
+// https://dev.twitch.tv/docs/api/reference#get-conduits
+func (client *Client) GetConduits() (*ConduitsResponse, error) {
+	if client.tokenType != "app" {
+		return nil, errors.New("Helix: Get Conduits endpoint requires an app token for authentication.")
+	}
+
+	data := new(ConduitsResponse)
+	resp, err := client.getRequest(conduitsPath, nil)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkResponse(resp); err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(resp.Data, data)
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+type conduitBody struct {
+	ID         string `json:"id,omitempty"`
+	ShardCount int    `json:"shard_count"`
+}
+
+// CreateConduit creates a conduit with shardCount shards.
+// Requires an app token.
+//
+// https://dev.twitch.tv/docs/api/reference#create-conduits
+func (client *Client) CreateConduit(shardCount int) (*ConduitsResponse, error) {
+	return client.writeConduit(&conduitBody{ShardCount: shardCount}, http.MethodPost)
+}
+
+// UpdateConduit changes the number of shards of the conduit identified by conduitID.
+// Shards removed by shrinking the conduit are disabled.
+// Requires an app token.
+//
+// https://dev.twitch.tv/docs/api/reference#update-conduits
+func (client *Client) UpdateConduit(conduitID string, shardCount int) (*ConduitsResponse, error) {
+	if conduitID == "" {
+		return nil, errors.New("Helix: Update Conduit requires a conduit ID.")
+	}
+	return client.writeConduit(&conduitBody{ID: conduitID, ShardCount: shardCount}, http.MethodPatch)
+}
+
+// writeConduit sends a conduit body using method, returning the created or updated conduit.
+func (client *Client) writeConduit(body *conduitBody, method string) (*ConduitsResponse, error) {
+	if client.tokenType != "app" {
+		return nil, errors.New("Helix: Conduits endpoints require an app token for authentication.")
+	}
+	if body.ShardCount < 1 || body.ShardCount > MaxConduitShards {
+		return nil, errors.New("Helix: Conduit shard count must be between 1 and 20000.")
+	}
+
+	data := new(ConduitsResponse)
+	resp, err := client.jsonRequest(conduitsPath, nil, body, method)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkResponse(resp); err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(resp.Data, data)
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// DeleteConduitOpt defines the options available for Delete Conduit.
+type DeleteConduitOpt struct {
+	ID string `url:"id"`
+}
+
+// DeleteConduit deletes a conduit and all of its subscriptions.
+// Requires an app token.
+//
+// https://dev.twitch.tv/docs/api/reference#delete-conduit
+func (client *Client) DeleteConduit(opt *DeleteConduitOpt) error {
+	if client.tokenType != "app" {
+		return errors.New("Helix: Delete Conduit endpoint requires an app token for authentication.")
+	}
+	if opt.ID == "" {
+		return errors.New("Helix: Delete Conduit requires a conduit ID.")
+	}
+
+	resp, err := client.deleteRequest(conduitsPath, opt)
+	if err != nil {
+		return err
+	}
+	return checkResponse(resp)
+}
+
+// ConduitShard represents a shard of a conduit and the transport its notifications are sent to.
+// Status is enabled, webhook_callback_verification_pending, or a reason the shard is disabled,
+// such as websocket_disconnected.
+type ConduitShard struct {
+	ID        string            `json:"id"`
+	Status    string            `json:"status,omitempty"`
+	Transport EventSubTransport `json:"transport"`
+}
+
+// GetConduitShardsOpt defines the options available for Get Conduit Shards.
+type GetConduitShardsOpt struct {
+	ConduitID string `url:"conduit_id"`
+	Status    string `url:"status,omitempty"`
+	After     string `url:"after,omitempty"`
+}
+
+// GetConduitShardsResponse represents a response from a Get Conduit Shards command.
+type GetConduitShardsResponse struct {
+	Data       []ConduitShard `json:"data,omitempty"`
+	Pagination PaginationData `json:"pagination,omitempty"`
+}
+
+// GetConduitShards returns a page of the shards of a conduit.
+// Requires an app token.
+//
+// https://dev.twitch.tv/docs/api/reference#get-conduit-shards
+func (client *Client) GetConduitShards(opt *GetConduitShardsOpt) (*GetConduitShardsResponse, error) {
+	if client.tokenType != "app" {
+		return nil, errors.New("Helix: Get Conduit Shards endpoint requires an app token for authentication.")
+	}
+	if opt.ConduitID == "" {
+		return nil, errors.New("Helix: Get Conduit Shards requires a conduit ID.")
+	}
+
+	data := new(GetConduitShardsResponse)
+	resp, err := client.getRequest(conduitShardsPath, opt)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkResponse(resp); err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(resp.Data, data)
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// UpdateConduitShardsBody represents the request body of an Update Conduit Shards command.
+// Each shard's transport must be a webhook or websocket transport.
+type UpdateConduitShardsBody struct {
+	ConduitID string         `json:"conduit_id"`
+	Shards    []ConduitShard `json:"shards"`
+}
+
+// ConduitShardError describes why a shard could not be updated.
+type ConduitShardError struct {
+	ID      string `json:"id"`
+	Message string `json:"message"`
+	Code    string `json:"code"`
+}
+
+// UpdateConduitShardsResponse represents a response from an Update Conduit Shards command.
+// Shards that could not be updated are listed in Errors rather than failing the whole request.
+type UpdateConduitShardsResponse struct {
+	Data   []ConduitShard      `json:"data,omitempty"`
+	Errors []ConduitShardError `json:"errors,omitempty"`
+}
+
+// UpdateConduitShards assigns transports to shards of a conduit.
+// Requires an app token.
+//
+// https://dev.twitch.tv/docs/api/reference#update-conduit-shards
+func (client *Client) UpdateConduitShards(body *UpdateConduitShardsBody) (*UpdateConduitShardsResponse, error) {
+	if client.tokenType != "app" {
+		return nil, errors.New("Helix: Update Conduit Shards endpoint requires an app token for authentication.")
+	}
+	if body.ConduitID == "" {
+		return nil, errors.New("Helix: Update Conduit Shards requires a conduit ID.")
+	}
+	if len(body.Shards) == 0 {
+		return nil, errors.New("Helix: Update Conduit Shards requires at least one shard.")
+	}
+	for _, s := range body.Shards {
+		if s.Transport.Method != "webhook" && s.Transport.Method != "websocket" {
+			return nil, errors.New("Helix: Conduit shard transport method must be webhook or websocket.")
+		}
+	}
+
+	data := new(UpdateConduitShardsResponse)
+	resp, err := client.jsonRequest(conduitShardsPath, nil, body, http.MethodPatch)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkResponse(resp); err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(resp.Data, data)
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
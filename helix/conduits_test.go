@@ -0,0 +1,79 @@
+package helix
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+// Tests that conduit shard counts and token types are validated before a request is made.
+func TestConduitValidation(t *testing.T) {
+	app := newMockClient(new(Config), "app", http.StatusOK, []byte(`{"data":[{"id":"bfcfc993","shard_count":5}]}`))
+	user := newMockClient(new(Config), "user", http.StatusOK, []byte(`{"data":[]}`))
+
+	if _, err := user.CreateConduit(5); err == nil {
+		t.Error("expected error for user token")
+	}
+	for _, count := range []int{0, MaxConduitShards + 1} {
+		if _, err := app.CreateConduit(count); err == nil {
+			t.Errorf("expected error for shard count %d", count)
+		}
+	}
+	if _, err := app.UpdateConduit("", 5); err == nil {
+		t.Error("expected error for missing conduit ID")
+	}
+
+	resp, err := app.CreateConduit(5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Data) != 1 || resp.Data[0].ShardCount != 5 {
+		t.Error("unexpected response:", resp.Data)
+	}
+}
+
+// Tests that UpdateConduitShards sends the shard transports and decodes per-shard errors.
+func TestUpdateConduitShards(t *testing.T) {
+	client := &Client{
+		conn: &mockHTTPClient{
+			response: func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPatch {
+					t.Errorf("wanted: %s\n got: %s\n", http.MethodPatch, r.Method)
+				}
+				body := new(UpdateConduitShardsBody)
+				if err := json.NewDecoder(r.Body).Decode(body); err != nil {
+					t.Error(err)
+				}
+				if len(body.Shards) != 2 || body.Shards[1].Transport.SessionID != "AQoQexAW" {
+					t.Error("unexpected body:", body)
+				}
+				w.WriteHeader(http.StatusAccepted)
+				w.Write([]byte(`{"data":[{"id":"0","status":"enabled","transport":{"method":"websocket","session_id":"AQoQILE9"}}],"errors":[{"id":"1","message":"websocket session is already in use","code":""}]}`))
+			},
+		},
+		config:    new(Config),
+		tokenType: "app",
+	}
+
+	resp, err := client.UpdateConduitShards(&UpdateConduitShardsBody{
+		ConduitID: "bfcfc993",
+		Shards: []ConduitShard{
+			{ID: "0", Transport: EventSubTransport{Method: "websocket", SessionID: "AQoQILE9"}},
+			{ID: "1", Transport: EventSubTransport{Method: "websocket", SessionID: "AQoQexAW"}},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Data) != 1 || len(resp.Errors) != 1 || resp.Errors[0].ID != "1" {
+		t.Error("unexpected response:", resp)
+	}
+
+	_, err = client.UpdateConduitShards(&UpdateConduitShardsBody{
+		ConduitID: "bfcfc993",
+		Shards:    []ConduitShard{{ID: "0", Transport: EventSubTransport{Method: "conduit"}}},
+	})
+	if err == nil {
+		t.Error("expected error for conduit transport")
+	}
+}
@@ -0,0 +1,181 @@
+package helix
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+)
+
+const (
+	dropsEntitlementsPath = "/entitlements/drops"
+	redeemCodePath        = "/entitlements/code"
+
+	// MaxEntitlementsPerUpdate is the maximum number of entitlement IDs accepted by Update Drops Entitlements.
+	MaxEntitlementsPerUpdate = 100
+)
+
+// GetDropsEntitlementsOpt defines the options available for Get Drops Entitlements.
+// FulfillmentStatus may be CLAIMED or FULFILLED.
+type GetDropsEntitlementsOpt struct {
+	ID                []string `url:"id,omitempty"`
+	UserID            string   `url:"user_id,omitempty"`
+	GameID            string   `url:"game_id,omitempty"`
+	FulfillmentStatus string   `url:"fulfillment_status,omitempty"`
+	After             string   `url:"after,omitempty"`
+	First             int      `url:"first,omitempty"`
+}
+
+// GetDropsEntitlementsData represents a drop entitlement granted to a user.
+type GetDropsEntitlementsData struct {
+	ID                string `json:"id,omitempty"`
+	BenefitID         string `json:"benefit_id,omitempty"`
+	Timestamp         string `json:"timestamp,omitempty"`
+	UserID            string `json:"user_id,omitempty"`
+	GameID            string `json:"game_id,omitempty"`
+	FulfillmentStatus string `json:"fulfillment_status,omitempty"`
+	LastUpdated       string `json:"last_updated,omitempty"`
+}
+
+// GetDropsEntitlementsResponse represents a response from a Get Drops Entitlements command.
+type GetDropsEntitlementsResponse struct {
+	Data       []GetDropsEntitlementsData `json:"data,omitempty"`
+	Pagination PaginationData             `json:"pagination,omitempty"`
+}
+
+// GetDropsEntitlements returns a page of drop entitlements for an organization, filtered by user, game or fulfillment status.
+// Works with both app and user access tokens. With a user token, only the entitlements of that user are returned.
+//
+// https://dev.twitch.tv/docs/api/reference#get-drops-entitlements
+func (client *Client) GetDropsEntitlements(opt *GetDropsEntitlementsOpt) (*GetDropsEntitlementsResponse, error) {
+	if opt != nil && !validFulfillmentStatus(opt.FulfillmentStatus, true) {
+		return nil, errors.New("Helix: Fulfillment status must be CLAIMED or FULFILLED.")
+	}
+	if opt != nil && len(opt.ID) > 100 {
+		return nil, errors.New("Helix: Cannot request more than 100 entitlement IDs per call.")
+	}
+
+	data := new(GetDropsEntitlementsResponse)
+	resp, err := client.getRequest(dropsEntitlementsPath, opt)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkResponse(resp); err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(resp.Data, data)
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// UpdateDropsEntitlementsBody represents the request body of an Update Drops Entitlements command.
+type UpdateDropsEntitlementsBody struct {
+	EntitlementIDs    []string `json:"entitlement_ids"`
+	FulfillmentStatus string   `json:"fulfillment_status"`
+}
+
+// UpdateDropsEntitlementsData represents the result of updating a group of entitlements.
+// Status is one of SUCCESS, INVALID_ID, NOT_FOUND, UNAUTHORIZED or UPDATE_FAILED.
+type UpdateDropsEntitlementsData struct {
+	Status string   `json:"status,omitempty"`
+	IDs    []string `json:"ids,omitempty"`
+}
+
+// UpdateDropsEntitlementsResponse represents a response from an Update Drops Entitlements command.
+type UpdateDropsEntitlementsResponse struct {
+	Data []UpdateDropsEntitlementsData `json:"data,omitempty"`
+}
+
+// UpdateDropsEntitlements sets the fulfillment status of up to 100 entitlements to CLAIMED or FULFILLED.
+// Setting an entitlement to the status it already has succeeds, so failed updates may be safely retried.
+// Works with both app and user access tokens.
+//
+// https://dev.twitch.tv/docs/api/reference#update-drops-entitlements
+func (client *Client) UpdateDropsEntitlements(body *UpdateDropsEntitlementsBody) (*UpdateDropsEntitlementsResponse, error) {
+	if !validFulfillmentStatus(body.FulfillmentStatus, false) {
+		return nil, errors.New("Helix: Fulfillment status must be CLAIMED or FULFILLED.")
+	}
+	if len(body.EntitlementIDs) == 0 {
+		return nil, errors.New("Helix: Update Drops Entitlements requires at least one entitlement ID.")
+	}
+	if len(body.EntitlementIDs) > MaxEntitlementsPerUpdate {
+		return nil, errors.New("Helix: Cannot update more than 100 entitlements per call.")
+	}
+
+	data := new(UpdateDropsEntitlementsResponse)
+	resp, err := client.jsonRequest(dropsEntitlementsPath, nil, body, http.MethodPatch)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkResponse(resp); err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(resp.Data, data)
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// RedeemCodeOpt defines the options available for Redeem Code.
+type RedeemCodeOpt struct {
+	Code   []string `url:"code"`
+	UserID string   `url:"user_id"`
+}
+
+// RedeemCodeData represents the redemption status of a code.
+// Status is one of SUCCESSFULLY_REDEEMED, ALREADY_CLAIMED, EXPIRED, USER_NOT_ELIGIBLE, NOT_FOUND,
+// INACTIVE, UNUSED, INCORRECT_FORMAT or INTERNAL_ERROR.
+type RedeemCodeData struct {
+	Code   string `json:"code,omitempty"`
+	Status string `json:"status,omitempty"`
+}
+
+// RedeemCodeResponse represents a response from a Redeem Code command.
+type RedeemCodeResponse struct {
+	Data []RedeemCodeData `json:"data,omitempty"`
+}
+
+// RedeemCode redeems up to 20 entitlement codes for a user. Requires an app access token
+// belonging to the organization that owns the codes.
+//
+// https://dev.twitch.tv/docs/api/reference#redeem-code
+func (client *Client) RedeemCode(opt *RedeemCodeOpt) (*RedeemCodeResponse, error) {
+	if client.tokenType != "app" {
+		return nil, errors.New("Helix: Redeem Code endpoint requires an app token for authentication.")
+	}
+	if len(opt.Code) == 0 {
+		return nil, errors.New("Helix: Redeem Code requires at least one code.")
+	}
+	if len(opt.Code) > 20 {
+		return nil, errors.New("Helix: Cannot redeem more than 20 codes per call.")
+	}
+	if opt.UserID == "" {
+		return nil, errors.New("Helix: Redeem Code requires a user ID.")
+	}
+
+	data := new(RedeemCodeResponse)
+	resp, err := client.postRequest(redeemCodePath, opt)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkResponse(resp); err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(resp.Data, data)
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func validFulfillmentStatus(status string, allowEmpty bool) bool {
+	switch status {
+	case "CLAIMED", "FULFILLED":
+		return true
+	case "":
+		return allowEmpty
+	}
+	return false
+}
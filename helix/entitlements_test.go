@@ -0,0 +1,53 @@
+package helix
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// Tests that UpdateDropsEntitlements validates its input and decodes the per status results.
+func TestUpdateDropsEntitlements(t *testing.T) {
+	client := newMockClient(new(Config), "app", http.StatusOK, []byte(`{"data":[{"status":"SUCCESS","ids":["a","b"]},{"status":"UPDATE_FAILED","ids":["c"]}]}`))
+
+	resp, err := client.UpdateDropsEntitlements(&UpdateDropsEntitlementsBody{
+		EntitlementIDs:    []string{"a", "b", "c"},
+		FulfillmentStatus: "FULFILLED",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []UpdateDropsEntitlementsData{
+		{Status: "SUCCESS", IDs: []string{"a", "b"}},
+		{Status: "UPDATE_FAILED", IDs: []string{"c"}},
+	}
+	if !cmp.Equal(want, resp.Data) {
+		t.Error(cmp.Diff(want, resp.Data))
+	}
+
+	if _, err := client.UpdateDropsEntitlements(&UpdateDropsEntitlementsBody{EntitlementIDs: []string{"a"}, FulfillmentStatus: "DONE"}); err == nil {
+		t.Error("expected error for invalid fulfillment status")
+	}
+	if _, err := client.UpdateDropsEntitlements(&UpdateDropsEntitlementsBody{EntitlementIDs: make([]string, 101), FulfillmentStatus: "CLAIMED"}); err == nil {
+		t.Error("expected error for more than 100 entitlement IDs")
+	}
+}
+
+// Tests that RedeemCode requires an app access token.
+func TestRedeemCodeTokenType(t *testing.T) {
+	opt := &RedeemCodeOpt{Code: []string{"KUHXV-4GXYP-AKAKK"}, UserID: "123"}
+	body := []byte(`{"data":[{"code":"KUHXV-4GXYP-AKAKK","status":"SUCCESSFULLY_REDEEMED"}]}`)
+
+	if _, err := newMockClient(new(Config), "user", http.StatusOK, body).RedeemCode(opt); err == nil {
+		t.Error("expected error for user token")
+	}
+
+	resp, err := newMockClient(new(Config), "app", http.StatusOK, body).RedeemCode(opt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Data) != 1 || resp.Data[0].Status != "SUCCESSFULLY_REDEEMED" {
+		t.Error("unexpected response:", resp.Data)
+	}
+}
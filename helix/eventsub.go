@@ -0,0 +1,276 @@
+package helix
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	eventSubSubscriptionsPath = "/eventsub/subscriptions"
+)
+
+// EventSubCondition represents the condition of an EventSub subscription.
+// Only the fields required by the subscription type should be set.
+type EventSubCondition struct {
+	BroadcasterUserID     string `json:"broadcaster_user_id,omitempty"`
+	ModeratorUserID       string `json:"moderator_user_id,omitempty"`
+	UserID                string `json:"user_id,omitempty"`
+	FromBroadcasterUserID string `json:"from_broadcaster_user_id,omitempty"`
+	ToBroadcasterUserID   string `json:"to_broadcaster_user_id,omitempty"`
+	RewardID              string `json:"reward_id,omitempty"`
+	ClientID              string `json:"client_id,omitempty"`
+	ExtensionClientID     string `json:"extension_client_id,omitempty"`
+	OrganizationID        string `json:"organization_id,omitempty"`
+	CategoryID            string `json:"category_id,omitempty"`
+	CampaignID            string `json:"campaign_id,omitempty"`
+}
+
+// The condition fields required by each subscription type. Types not listed here are not validated.
+var eventSubConditionFields = map[string][]string{
+	"channel.update":                                         {"broadcaster_user_id"},
+	"channel.follow":                                         {"broadcaster_user_id", "moderator_user_id"},
+	"channel.subscribe":                                      {"broadcaster_user_id"},
+	"channel.subscription.end":                               {"broadcaster_user_id"},
+	"channel.subscription.gift":                              {"broadcaster_user_id"},
+	"channel.subscription.message":                           {"broadcaster_user_id"},
+	"channel.cheer":                                          {"broadcaster_user_id"},
+	"channel.raid":                                           {},
+	"channel.ban":                                            {"broadcaster_user_id"},
+	"channel.unban":                                          {"broadcaster_user_id"},
+	"channel.moderator.add":                                  {"broadcaster_user_id"},
+	"channel.moderator.remove":                               {"broadcaster_user_id"},
+	"channel.channel_points_custom_reward.add":               {"broadcaster_user_id"},
+	"channel.channel_points_custom_reward.update":            {"broadcaster_user_id"},
+	"channel.channel_points_custom_reward.remove":            {"broadcaster_user_id"},
+	"channel.channel_points_custom_reward_redemption.add":    {"broadcaster_user_id"},
+	"channel.channel_points_custom_reward_redemption.update": {"broadcaster_user_id"},
+	"channel.chat.message":                                   {"broadcaster_user_id", "user_id"},
+	"channel.shield_mode.begin":                              {"broadcaster_user_id", "moderator_user_id"},
+	"channel.shield_mode.end":                                {"broadcaster_user_id", "moderator_user_id"},
+	"channel.charity_campaign.progress":                      {"broadcaster_user_id"},
+	"channel.goal.progress":                                  {"broadcaster_user_id"},
+	"stream.online":                                          {"broadcaster_user_id"},
+	"stream.offline":                                         {"broadcaster_user_id"},
+	"user.update":                                            {"user_id"},
+	"user.authorization.grant":                               {"client_id"},
+	"user.authorization.revoke":                              {"client_id"},
+	"drop.entitlement.grant":                                 {"organization_id"},
+	"extension.bits_transaction.create":                      {"extension_client_id"},
+	"conduit.shard.disabled":                                 {"client_id"},
+}
+
+// fields returns the condition as a map of JSON field name to value, omitting empty fields.
+func (c *EventSubCondition) fields() map[string]string {
+	fields := map[string]string{
+		"broadcaster_user_id":      c.BroadcasterUserID,
+		"moderator_user_id":        c.ModeratorUserID,
+		"user_id":                  c.UserID,
+		"from_broadcaster_user_id": c.FromBroadcasterUserID,
+		"to_broadcaster_user_id":   c.ToBroadcasterUserID,
+		"reward_id":                c.RewardID,
+		"client_id":                c.ClientID,
+		"extension_client_id":      c.ExtensionClientID,
+		"organization_id":          c.OrganizationID,
+		"category_id":              c.CategoryID,
+		"campaign_id":              c.CampaignID,
+	}
+	for name, value := range fields {
+		if value == "" {
+			delete(fields, name)
+		}
+	}
+	return fields
+}
+
+// EventSubTransport represents how the notifications of a subscription are delivered.
+// Method is webhook, websocket or conduit. Webhooks require Callback and Secret, WebSockets require
+// SessionID and conduits require ConduitID. Secret is never returned by the API.
+type EventSubTransport struct {
+	Method         string     `json:"method"`
+	Callback       string     `json:"callback,omitempty"`
+	Secret         string     `json:"secret,omitempty"`
+	SessionID      string     `json:"session_id,omitempty"`
+	ConduitID      string     `json:"conduit_id,omitempty"`
+	ConnectedAt    *time.Time `json:"connected_at,omitempty"`
+	DisconnectedAt *time.Time `json:"disconnected_at,omitempty"`
+}
+
+// EventSubSubscription represents an EventSub subscription.
+type EventSubSubscription struct {
+	ID        string            `json:"id,omitempty"`
+	Status    string            `json:"status,omitempty"`
+	Type      string            `json:"type,omitempty"`
+	Version   string            `json:"version,omitempty"`
+	Condition EventSubCondition `json:"condition"`
+	CreatedAt time.Time         `json:"created_at,omitempty"`
+	Transport EventSubTransport `json:"transport"`
+	Cost      int               `json:"cost"`
+}
+
+// EventSubSubscriptionsResponse represents a response from a Create or Get EventSub Subscriptions command.
+// TotalCost is the sum of the cost of all enabled subscriptions, which may not exceed MaxTotalCost.
+type EventSubSubscriptionsResponse struct {
+	Data         []EventSubSubscription `json:"data,omitempty"`
+	Total        int                    `json:"total"`
+	TotalCost    int                    `json:"total_cost"`
+	MaxTotalCost int                    `json:"max_total_cost"`
+	Pagination   PaginationData         `json:"pagination,omitempty"`
+}
+
+// CreateEventSubSubscriptionBody represents the request body of a Create EventSub Subscription command.
+type CreateEventSubSubscriptionBody struct {
+	Type      string            `json:"type"`
+	Version   string            `json:"version"`
+	Condition EventSubCondition `json:"condition"`
+	Transport EventSubTransport `json:"transport"`
+}
+
+// CreateEventSubSubscription creates a subscription to an EventSub event type.
+// Webhook and conduit subscriptions require an app token, and WebSocket subscriptions require a user token.
+// The condition is checked against the fields required by known subscription types before the request is sent.
+//
+// https://dev.twitch.tv/docs/api/reference#create-eventsub-subscription
+func (client *Client) CreateEventSubSubscription(body *CreateEventSubSubscriptionBody) (*EventSubSubscriptionsResponse, error) {
+	if body.Type == "" || body.Version == "" {
+		return nil, errors.New("Helix: Create EventSub Subscription requires a type and version.")
+	}
+	if err := client.checkEventSubTransport(&body.Transport); err != nil {
+		return nil, err
+	}
+	if err := checkEventSubCondition(body.Type, &body.Condition); err != nil {
+		return nil, err
+	}
+
+	data := new(EventSubSubscriptionsResponse)
+	resp, err := client.jsonRequest(eventSubSubscriptionsPath, nil, body, http.MethodPost)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkResponse(resp); err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(resp.Data, data)
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// GetEventSubSubscriptionsOpt defines the options available for Get EventSub Subscriptions.
+// Only one of Status, Type, UserID or SubscriptionID may be set.
+type GetEventSubSubscriptionsOpt struct {
+	Status         string `url:"status,omitempty"`
+	Type           string `url:"type,omitempty"`
+	UserID         string `url:"user_id,omitempty"`
+	SubscriptionID string `url:"subscription_id,omitempty"`
+	After          string `url:"after,omitempty"`
+}
+
+// GetEventSubSubscriptions returns a page of the EventSub subscriptions created by the client.
+//
+// https://dev.twitch.tv/docs/api/reference#get-eventsub-subscriptions
+func (client *Client) GetEventSubSubscriptions(opt *GetEventSubSubscriptionsOpt) (*EventSubSubscriptionsResponse, error) {
+	if opt != nil {
+		filters := 0
+		for _, f := range []string{opt.Status, opt.Type, opt.UserID, opt.SubscriptionID} {
+			if f != "" {
+				filters++
+			}
+		}
+		if filters > 1 {
+			return nil, errors.New("Helix: Get EventSub Subscriptions accepts only one of status, type, user ID or subscription ID.")
+		}
+	}
+
+	data := new(EventSubSubscriptionsResponse)
+	resp, err := client.getRequest(eventSubSubscriptionsPath, opt)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkResponse(resp); err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(resp.Data, data)
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// DeleteEventSubSubscriptionOpt defines the options available for Delete EventSub Subscription.
+type DeleteEventSubSubscriptionOpt struct {
+	ID string `url:"id"`
+}
+
+// DeleteEventSubSubscription deletes an EventSub subscription.
+//
+// https://dev.twitch.tv/docs/api/reference#delete-eventsub-subscription
+func (client *Client) DeleteEventSubSubscription(opt *DeleteEventSubSubscriptionOpt) error {
+	if opt.ID == "" {
+		return errors.New("Helix: Delete EventSub Subscription requires a subscription ID.")
+	}
+
+	resp, err := client.deleteRequest(eventSubSubscriptionsPath, opt)
+	if err != nil {
+		return err
+	}
+	return checkResponse(resp)
+}
+
+// checkEventSubTransport returns an error if the transport is missing fields required by its method,
+// or if the token type cannot be used with the method.
+func (client *Client) checkEventSubTransport(t *EventSubTransport) error {
+	switch t.Method {
+	case "webhook":
+		if client.tokenType != "app" {
+			return errors.New("Helix: EventSub webhook subscriptions require an app token for authentication.")
+		}
+		if !strings.HasPrefix(t.Callback, "https://") {
+			return errors.New("Helix: EventSub webhook callback must be an https URL.")
+		}
+		if len(t.Secret) < 10 || len(t.Secret) > 100 {
+			return errors.New("Helix: EventSub webhook secret must be between 10 and 100 characters.")
+		}
+	case "websocket":
+		if client.tokenType != "user" {
+			return errors.New("Helix: EventSub WebSocket subscriptions require a user token for authentication.")
+		}
+		if t.SessionID == "" {
+			return errors.New("Helix: EventSub WebSocket subscriptions require a session ID.")
+		}
+	case "conduit":
+		if client.tokenType != "app" {
+			return errors.New("Helix: EventSub conduit subscriptions require an app token for authentication.")
+		}
+		if t.ConduitID == "" {
+			return errors.New("Helix: EventSub conduit subscriptions require a conduit ID.")
+		}
+	default:
+		return errors.New("Helix: EventSub transport method must be webhook, websocket or conduit.")
+	}
+	return nil
+}
+
+// checkEventSubCondition returns an error if the condition is missing a field required by the subscription type.
+func checkEventSubCondition(subscriptionType string, condition *EventSubCondition) error {
+	required, ok := eventSubConditionFields[subscriptionType]
+	if !ok {
+		return nil
+	}
+
+	fields := condition.fields()
+	for _, f := range required {
+		if fields[f] == "" {
+			return errors.New("Helix: EventSub " + subscriptionType + " condition requires " + f)
+		}
+	}
+
+	// Raids are filtered by exactly one side of the raid.
+	if subscriptionType == "channel.raid" && (fields["from_broadcaster_user_id"] == "") == (fields["to_broadcaster_user_id"] == "") {
+		return errors.New("Helix: EventSub channel.raid condition requires exactly one of from_broadcaster_user_id or to_broadcaster_user_id")
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+package helix
+
+import (
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// Tests that subscriptions are validated against their transport and condition before being created.
+func TestCreateEventSubSubscriptionValidation(t *testing.T) {
+	webhook := EventSubTransport{Method: "webhook", Callback: "https://example.com/eventsub", Secret: "s3cr3t-s3cr3t"}
+	websocket := EventSubTransport{Method: "websocket", SessionID: "AQoQexAWVYKSTIu4ec_2VAxyuhAB"}
+	cases := []struct {
+		tokenType string
+		body      CreateEventSubSubscriptionBody
+		valid     bool
+	}{
+		{"app", CreateEventSubSubscriptionBody{Type: "stream.online", Version: "1", Condition: EventSubCondition{BroadcasterUserID: "1337"}, Transport: webhook}, true},
+		{"app", CreateEventSubSubscriptionBody{Type: "stream.online", Version: "1", Transport: webhook}, false},
+		{"user", CreateEventSubSubscriptionBody{Type: "stream.online", Version: "1", Condition: EventSubCondition{BroadcasterUserID: "1337"}, Transport: webhook}, false},
+		{"user", CreateEventSubSubscriptionBody{Type: "channel.follow", Version: "2", Condition: EventSubCondition{BroadcasterUserID: "1337", ModeratorUserID: "1337"}, Transport: websocket}, true},
+		{"user", CreateEventSubSubscriptionBody{Type: "channel.follow", Version: "2", Condition: EventSubCondition{BroadcasterUserID: "1337"}, Transport: websocket}, false},
+		{"app", CreateEventSubSubscriptionBody{Type: "channel.raid", Version: "1", Condition: EventSubCondition{ToBroadcasterUserID: "1337"}, Transport: webhook}, true},
+		{"app", CreateEventSubSubscriptionBody{Type: "channel.raid", Version: "1", Transport: webhook}, false},
+		{"app", CreateEventSubSubscriptionBody{Type: "stream.online", Version: "1", Condition: EventSubCondition{BroadcasterUserID: "1337"}, Transport: EventSubTransport{Method: "webhook", Callback: "http://example.com", Secret: "s3cr3t-s3cr3t"}}, false},
+	}
+
+	for i, c := range cases {
+		client := newMockClient(new(Config), c.tokenType, http.StatusAccepted, []byte(`{"data":[{"id":"26b1c993","status":"webhook_callback_verification_pending","type":"stream.online","version":"1","cost":1}],"total":1,"total_cost":1,"max_total_cost":10000}`))
+		resp, err := client.CreateEventSubSubscription(&c.body)
+		if c.valid && err != nil {
+			t.Errorf("case %d: %v", i, err)
+		}
+		if !c.valid && err == nil {
+			t.Errorf("case %d: expected error", i)
+		}
+		if c.valid && err == nil && (resp.TotalCost != 1 || resp.MaxTotalCost != 10000) {
+			t.Errorf("case %d: unexpected cost: %d/%d", i, resp.TotalCost, resp.MaxTotalCost)
+		}
+	}
+}
+
+// Tests that every condition field is checked under its JSON field name.
+func TestEventSubConditionFields(t *testing.T) {
+	var condition EventSubCondition
+	v := reflect.ValueOf(&condition).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		v.Field(i).SetString(v.Type().Field(i).Name)
+	}
+
+	fields := condition.fields()
+	if len(fields) != v.NumField() {
+		t.Errorf("wanted %d fields, got: %v", v.NumField(), fields)
+	}
+	for i := 0; i < v.NumField(); i++ {
+		f := v.Type().Field(i)
+		name := strings.Split(f.Tag.Get("json"), ",")[0]
+		if fields[name] != f.Name {
+			t.Errorf("wanted %s for %s, got: %s", f.Name, name, fields[name])
+		}
+	}
+	if len((&EventSubCondition{BroadcasterUserID: "1337"}).fields()) != 1 {
+		t.Error("expected empty fields to be omitted")
+	}
+}
@@ -10,8 +10,8 @@ const (
 
 // GetGamesOpt defines the options available for Get Games.
 type GetGamesOpt struct {
-	ID   string `url:"id,omitempty"`
-	Name string `url:"name,omitempty"`
+	ID   []string `url:"id,omitempty"`
+	Name []string `url:"name,omitempty"`
 }
 
 // GetGamesData represents metadata about a game.
@@ -27,10 +27,20 @@ type GetGamesResponse struct {
 	Pagination PaginationData
 }
 
-// GetGames gets information by game name or game id
+// GetGames gets information by game name or game id.
+// Up to 100 IDs and 100 names may be provided.
 //
 // https://dev.twitch.tv/docs/api/reference/#get-games
 func (client *Client) GetGames(opt *GetGamesOpt) (*GetGamesResponse, error) {
+	if opt != nil {
+		if err := checkQueryValues("id", opt.ID); err != nil {
+			return nil, err
+		}
+		if err := checkQueryValues("name", opt.Name); err != nil {
+			return nil, err
+		}
+	}
+
 	data := new(GetGamesResponse)
 	resp, err := client.getRequest(getGamesPath, opt)
 	if err != nil {
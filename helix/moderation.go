@@ -0,0 +1,493 @@
+package helix
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"unicode/utf8"
+)
+
+const (
+	checkAutoModStatusPath = "/moderation/enforcements/status"
+	manageAutoModPath      = "/moderation/automod/message"
+	blockedTermsPath       = "/moderation/blocked_terms"
+	shieldModePath         = "/moderation/shield_mode"
+	warningsPath           = "/moderation/warnings"
+	unbanRequestsPath      = "/moderation/unban_requests"
+	warningReasonLimit     = 500
+)
+
+// CheckAutoModStatusOpt defines the options available for Check AutoMod Status.
+type CheckAutoModStatusOpt struct {
+	BroadcasterID string `url:"broadcaster_id"`
+}
+
+// AutoModMessage represents a message to be checked against the broadcaster's AutoMod settings.
+// MsgID is a caller defined ID used to match the message to its result.
+type AutoModMessage struct {
+	MsgID   string `json:"msg_id"`
+	MsgText string `json:"msg_text"`
+}
+
+// CheckAutoModStatusBody represents the request body of a Check AutoMod Status command.
+type CheckAutoModStatusBody struct {
+	Data []AutoModMessage `json:"data"`
+}
+
+// CheckAutoModStatusData represents whether a message would be permitted by AutoMod.
+type CheckAutoModStatusData struct {
+	MsgID       string `json:"msg_id,omitempty"`
+	IsPermitted bool   `json:"is_permitted"`
+}
+
+// CheckAutoModStatusResponse represents a response from a Check AutoMod Status command.
+type CheckAutoModStatusResponse struct {
+	Data []CheckAutoModStatusData `json:"data,omitempty"`
+}
+
+// CheckAutoModStatus checks whether up to 100 messages would be held by AutoMod in the broadcaster's channel.
+// Requires scope: moderation:read
+//
+// https://dev.twitch.tv/docs/api/reference#check-automod-status
+func (client *Client) CheckAutoModStatus(opt *CheckAutoModStatusOpt, messages []AutoModMessage) (*CheckAutoModStatusResponse, error) {
+	if client.tokenType != "user" {
+		return nil, errors.New("Helix: Check AutoMod Status endpoint requires a user token for authentication.")
+	}
+	if !client.hasScope("moderation:read") {
+		return nil, errors.New("Helix: Missing required scope for Check AutoMod Status- moderation:read")
+	}
+	if len(messages) == 0 {
+		return nil, errors.New("Helix: Check AutoMod Status requires at least one message.")
+	}
+	if len(messages) > 100 {
+		return nil, errors.New("Helix: Cannot check more than 100 messages per call.")
+	}
+
+	data := new(CheckAutoModStatusResponse)
+	resp, err := client.jsonRequest(checkAutoModStatusPath, opt, &CheckAutoModStatusBody{Data: messages}, http.MethodPost)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkResponse(resp); err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(resp.Data, data)
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// ManageHeldAutoModMessageBody represents the request body of a Manage Held AutoMod Messages command.
+// UserID is the moderator approving or denying the message. Action must be ALLOW or DENY.
+type ManageHeldAutoModMessageBody struct {
+	UserID string `json:"user_id"`
+	MsgID  string `json:"msg_id"`
+	Action string `json:"action"`
+}
+
+// ManageHeldAutoModMessage allows or denies a chat message that AutoMod is holding for review.
+// Requires scope: moderator:manage:automod
+//
+// https://dev.twitch.tv/docs/api/reference#manage-held-automod-messages
+func (client *Client) ManageHeldAutoModMessage(body *ManageHeldAutoModMessageBody) error {
+	if client.tokenType != "user" {
+		return errors.New("Helix: Manage Held AutoMod Messages endpoint requires a user token for authentication.")
+	}
+	if !client.hasScope("moderator:manage:automod") {
+		return errors.New("Helix: Missing required scope for Manage Held AutoMod Messages- moderator:manage:automod")
+	}
+	if body.Action != "ALLOW" && body.Action != "DENY" {
+		return errors.New("Helix: AutoMod action must be ALLOW or DENY.")
+	}
+
+	resp, err := client.jsonRequest(manageAutoModPath, nil, body, http.MethodPost)
+	if err != nil {
+		return err
+	}
+	return checkResponse(resp)
+}
+
+// GetBlockedTermsOpt defines the options available for Get Blocked Terms.
+// ModeratorID must match the user of the access token.
+type GetBlockedTermsOpt struct {
+	BroadcasterID string `url:"broadcaster_id"`
+	ModeratorID   string `url:"moderator_id"`
+	First         int    `url:"first,omitempty"`
+	After         string `url:"after,omitempty"`
+}
+
+// BlockedTermData represents a term blocked in a broadcaster's chat.
+// ExpiresAt is empty if the term does not expire.
+type BlockedTermData struct {
+	BroadcasterID string `json:"broadcaster_id,omitempty"`
+	ModeratorID   string `json:"moderator_id,omitempty"`
+	ID            string `json:"id,omitempty"`
+	Text          string `json:"text,omitempty"`
+	CreatedAt     string `json:"created_at,omitempty"`
+	UpdatedAt     string `json:"updated_at,omitempty"`
+	ExpiresAt     string `json:"expires_at,omitempty"`
+}
+
+// GetBlockedTermsResponse represents a response from a Get Blocked Terms command.
+type GetBlockedTermsResponse struct {
+	Data       []BlockedTermData `json:"data,omitempty"`
+	Pagination PaginationData    `json:"pagination,omitempty"`
+}
+
+// GetBlockedTerms returns a page of the terms blocked in the broadcaster's chat.
+// Requires scope: moderator:read:blocked_terms
+//
+// https://dev.twitch.tv/docs/api/reference#get-blocked-terms
+func (client *Client) GetBlockedTerms(opt *GetBlockedTermsOpt) (*GetBlockedTermsResponse, error) {
+	if client.tokenType != "user" {
+		return nil, errors.New("Helix: Get Blocked Terms endpoint requires a user token for authentication.")
+	}
+	if !client.hasScope("moderator:read:blocked_terms") && !client.hasScope("moderator:manage:blocked_terms") {
+		return nil, errors.New("Helix: Missing required scope for Get Blocked Terms- moderator:read:blocked_terms")
+	}
+
+	data := new(GetBlockedTermsResponse)
+	resp, err := client.getRequest(blockedTermsPath, opt)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkResponse(resp); err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(resp.Data, data)
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// AddBlockedTermOpt defines the options available for Add Blocked Term.
+// ModeratorID must match the user of the access token.
+type AddBlockedTermOpt struct {
+	BroadcasterID string `url:"broadcaster_id"`
+	ModeratorID   string `url:"moderator_id"`
+}
+
+type addBlockedTermBody struct {
+	Text string `json:"text"`
+}
+
+// AddBlockedTermResponse represents a response from an Add Blocked Term command.
+type AddBlockedTermResponse struct {
+	Data []BlockedTermData `json:"data,omitempty"`
+}
+
+// AddBlockedTerm blocks a term in the broadcaster's chat. The term must be between 2 and 500 characters
+// and may use a * wildcard. Adding a term that is already blocked returns the existing term.
+// Requires scope: moderator:manage:blocked_terms
+//
+// https://dev.twitch.tv/docs/api/reference#add-blocked-term
+func (client *Client) AddBlockedTerm(opt *AddBlockedTermOpt, text string) (*AddBlockedTermResponse, error) {
+	if client.tokenType != "user" {
+		return nil, errors.New("Helix: Add Blocked Term endpoint requires a user token for authentication.")
+	}
+	if !client.hasScope("moderator:manage:blocked_terms") {
+		return nil, errors.New("Helix: Missing required scope for Add Blocked Term- moderator:manage:blocked_terms")
+	}
+	if n := utf8.RuneCountInString(text); n < 2 || n > 500 {
+		return nil, errors.New("Helix: Blocked term must be between 2 and 500 characters.")
+	}
+
+	data := new(AddBlockedTermResponse)
+	resp, err := client.jsonRequest(blockedTermsPath, opt, &addBlockedTermBody{Text: text}, http.MethodPost)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkResponse(resp); err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(resp.Data, data)
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// RemoveBlockedTermOpt defines the options available for Remove Blocked Term.
+// ModeratorID must match the user of the access token.
+type RemoveBlockedTermOpt struct {
+	BroadcasterID string `url:"broadcaster_id"`
+	ModeratorID   string `url:"moderator_id"`
+	ID            string `url:"id"`
+}
+
+// RemoveBlockedTerm removes a blocked term from the broadcaster's chat.
+// Requires scope: moderator:manage:blocked_terms
+//
+// https://dev.twitch.tv/docs/api/reference#remove-blocked-term
+func (client *Client) RemoveBlockedTerm(opt *RemoveBlockedTermOpt) error {
+	if client.tokenType != "user" {
+		return errors.New("Helix: Remove Blocked Term endpoint requires a user token for authentication.")
+	}
+	if !client.hasScope("moderator:manage:blocked_terms") {
+		return errors.New("Helix: Missing required scope for Remove Blocked Term- moderator:manage:blocked_terms")
+	}
+
+	resp, err := client.deleteRequest(blockedTermsPath, opt)
+	if err != nil {
+		return err
+	}
+	return checkResponse(resp)
+}
+
+// ShieldModeOpt defines the options available for Get Shield Mode Status and Update Shield Mode Status.
+// ModeratorID must match the user of the access token.
+type ShieldModeOpt struct {
+	BroadcasterID string `url:"broadcaster_id"`
+	ModeratorID   string `url:"moderator_id"`
+}
+
+type updateShieldModeBody struct {
+	IsActive bool `json:"is_active"`
+}
+
+// ShieldModeData represents the Shield Mode status of a broadcaster's chat.
+// The moderator fields identify the moderator who last activated Shield Mode.
+type ShieldModeData struct {
+	IsActive        bool   `json:"is_active"`
+	ModeratorID     string `json:"moderator_id,omitempty"`
+	ModeratorLogin  string `json:"moderator_login,omitempty"`
+	ModeratorName   string `json:"moderator_name,omitempty"`
+	LastActivatedAt string `json:"last_activated_at,omitempty"`
+}
+
+// ShieldModeResponse represents a response from a Get or Update Shield Mode Status command.
+type ShieldModeResponse struct {
+	Data []ShieldModeData `json:"data,omitempty"`
+}
+
+// GetShieldModeStatus returns whether Shield Mode is active in the broadcaster's chat.
+// Requires scope: moderator:read:shield_mode or moderator:manage:shield_mode
+//
+// https://dev.twitch.tv/docs/api/reference#get-shield-mode-status
+func (client *Client) GetShieldModeStatus(opt *ShieldModeOpt) (*ShieldModeResponse, error) {
+	if client.tokenType != "user" {
+		return nil, errors.New("Helix: Get Shield Mode Status endpoint requires a user token for authentication.")
+	}
+	if !client.hasScope("moderator:read:shield_mode") && !client.hasScope("moderator:manage:shield_mode") {
+		return nil, errors.New("Helix: Missing required scope for Get Shield Mode Status- moderator:read:shield_mode")
+	}
+
+	data := new(ShieldModeResponse)
+	resp, err := client.getRequest(shieldModePath, opt)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkResponse(resp); err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(resp.Data, data)
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// UpdateShieldModeStatus activates or deactivates Shield Mode in the broadcaster's chat.
+// Requires scope: moderator:manage:shield_mode
+//
+// https://dev.twitch.tv/docs/api/reference#update-shield-mode-status
+func (client *Client) UpdateShieldModeStatus(opt *ShieldModeOpt, active bool) (*ShieldModeResponse, error) {
+	if client.tokenType != "user" {
+		return nil, errors.New("Helix: Update Shield Mode Status endpoint requires a user token for authentication.")
+	}
+	if !client.hasScope("moderator:manage:shield_mode") {
+		return nil, errors.New("Helix: Missing required scope for Update Shield Mode Status- moderator:manage:shield_mode")
+	}
+
+	data := new(ShieldModeResponse)
+	resp, err := client.jsonRequest(shieldModePath, opt, &updateShieldModeBody{IsActive: active}, http.MethodPut)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkResponse(resp); err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(resp.Data, data)
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// WarnChatUserOpt defines the options available for Warn Chat User.
+// ModeratorID must match the user of the access token.
+type WarnChatUserOpt struct {
+	BroadcasterID string `url:"broadcaster_id"`
+	ModeratorID   string `url:"moderator_id"`
+}
+
+// WarnChatUserBody represents the warning sent by a Warn Chat User command.
+type WarnChatUserBody struct {
+	UserID string `json:"user_id"`
+	Reason string `json:"reason"`
+}
+
+type warnChatUserRequest struct {
+	Data WarnChatUserBody `json:"data"`
+}
+
+// WarnChatUserData represents a warning sent to a user.
+type WarnChatUserData struct {
+	BroadcasterID string `json:"broadcaster_id,omitempty"`
+	UserID        string `json:"user_id,omitempty"`
+	ModeratorID   string `json:"moderator_id,omitempty"`
+	Reason        string `json:"reason,omitempty"`
+}
+
+// WarnChatUserResponse represents a response from a Warn Chat User command.
+type WarnChatUserResponse struct {
+	Data []WarnChatUserData `json:"data,omitempty"`
+}
+
+// WarnChatUser warns a user in the broadcaster's chat. The user must acknowledge the warning before chatting again.
+// The reason is required and may be up to 500 characters.
+// Requires scope: moderator:manage:warnings
+//
+// https://dev.twitch.tv/docs/api/reference#warn-chat-user
+func (client *Client) WarnChatUser(opt *WarnChatUserOpt, body *WarnChatUserBody) (*WarnChatUserResponse, error) {
+	if client.tokenType != "user" {
+		return nil, errors.New("Helix: Warn Chat User endpoint requires a user token for authentication.")
+	}
+	if !client.hasScope("moderator:manage:warnings") {
+		return nil, errors.New("Helix: Missing required scope for Warn Chat User- moderator:manage:warnings")
+	}
+	if body.Reason == "" {
+		return nil, errors.New("Helix: Warn Chat User requires a reason.")
+	}
+	if utf8.RuneCountInString(body.Reason) > warningReasonLimit {
+		return nil, errors.New("Helix: Warning reason exceeds the maximum length of 500 characters.")
+	}
+
+	data := new(WarnChatUserResponse)
+	resp, err := client.jsonRequest(warningsPath, opt, &warnChatUserRequest{Data: *body}, http.MethodPost)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkResponse(resp); err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(resp.Data, data)
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// GetUnbanRequestsOpt defines the options available for Get Unban Requests.
+// Status is required and is one of pending, approved, denied, acknowledged or canceled.
+// ModeratorID must match the user of the access token.
+type GetUnbanRequestsOpt struct {
+	BroadcasterID string `url:"broadcaster_id"`
+	ModeratorID   string `url:"moderator_id"`
+	Status        string `url:"status"`
+	UserID        string `url:"user_id,omitempty"`
+	After         string `url:"after,omitempty"`
+	First         int    `url:"first,omitempty"`
+}
+
+// UnbanRequestData represents a request from a banned user to be unbanned.
+type UnbanRequestData struct {
+	ID               string `json:"id,omitempty"`
+	BroadcasterID    string `json:"broadcaster_id,omitempty"`
+	BroadcasterLogin string `json:"broadcaster_login,omitempty"`
+	BroadcasterName  string `json:"broadcaster_name,omitempty"`
+	ModeratorID      string `json:"moderator_id,omitempty"`
+	ModeratorLogin   string `json:"moderator_login,omitempty"`
+	ModeratorName    string `json:"moderator_name,omitempty"`
+	UserID           string `json:"user_id,omitempty"`
+	UserLogin        string `json:"user_login,omitempty"`
+	UserName         string `json:"user_name,omitempty"`
+	Text             string `json:"text,omitempty"`
+	Status           string `json:"status,omitempty"`
+	CreatedAt        string `json:"created_at,omitempty"`
+	ResolvedAt       string `json:"resolved_at,omitempty"`
+	ResolutionText   string `json:"resolution_text,omitempty"`
+}
+
+// UnbanRequestsResponse represents a response from a Get or Resolve Unban Requests command.
+type UnbanRequestsResponse struct {
+	Data       []UnbanRequestData `json:"data,omitempty"`
+	Pagination PaginationData     `json:"pagination,omitempty"`
+}
+
+// GetUnbanRequests returns a page of the unban requests for the broadcaster's channel with the given status.
+// Requires scope: moderator:read:unban_requests or moderator:manage:unban_requests
+//
+// https://dev.twitch.tv/docs/api/reference#get-unban-requests
+func (client *Client) GetUnbanRequests(opt *GetUnbanRequestsOpt) (*UnbanRequestsResponse, error) {
+	if client.tokenType != "user" {
+		return nil, errors.New("Helix: Get Unban Requests endpoint requires a user token for authentication.")
+	}
+	if !client.hasScope("moderator:read:unban_requests") && !client.hasScope("moderator:manage:unban_requests") {
+		return nil, errors.New("Helix: Missing required scope for Get Unban Requests- moderator:read:unban_requests")
+	}
+	switch opt.Status {
+	case "pending", "approved", "denied", "acknowledged", "canceled":
+	default:
+		return nil, errors.New("Helix: Unban request status must be pending, approved, denied, acknowledged or canceled.")
+	}
+
+	data := new(UnbanRequestsResponse)
+	resp, err := client.getRequest(unbanRequestsPath, opt)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkResponse(resp); err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(resp.Data, data)
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// ResolveUnbanRequestOpt defines the options available for Resolve Unban Requests.
+// Status must be approved or denied. ModeratorID must match the user of the access token.
+type ResolveUnbanRequestOpt struct {
+	BroadcasterID  string `url:"broadcaster_id"`
+	ModeratorID    string `url:"moderator_id"`
+	UnbanRequestID string `url:"unban_request_id"`
+	Status         string `url:"status"`
+	ResolutionText string `url:"resolution_text,omitempty"`
+}
+
+// ResolveUnbanRequest approves or denies an unban request. Approving a request unbans the user.
+// Requires scope: moderator:manage:unban_requests
+//
+// https://dev.twitch.tv/docs/api/reference#resolve-unban-requests
+func (client *Client) ResolveUnbanRequest(opt *ResolveUnbanRequestOpt) (*UnbanRequestsResponse, error) {
+	if client.tokenType != "user" {
+		return nil, errors.New("Helix: Resolve Unban Requests endpoint requires a user token for authentication.")
+	}
+	if !client.hasScope("moderator:manage:unban_requests") {
+		return nil, errors.New("Helix: Missing required scope for Resolve Unban Requests- moderator:manage:unban_requests")
+	}
+	if opt.Status != "approved" && opt.Status != "denied" {
+		return nil, errors.New("Helix: Unban request resolution must be approved or denied.")
+	}
+	if utf8.RuneCountInString(opt.ResolutionText) > 500 {
+		return nil, errors.New("Helix: Unban request resolution text exceeds the maximum length of 500 characters.")
+	}
+
+	data := new(UnbanRequestsResponse)
+	resp, err := client.patchRequest(unbanRequestsPath, opt)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkResponse(resp); err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(resp.Data, data)
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
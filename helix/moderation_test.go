@@ -0,0 +1,85 @@
+package helix
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+// Tests that ManageHeldAutoModMessage validates the action and sends it in the body.
+func TestManageHeldAutoModMessage(t *testing.T) {
+	client := &Client{
+		conn: &mockHTTPClient{
+			response: func(w http.ResponseWriter, r *http.Request) {
+				body := new(ManageHeldAutoModMessageBody)
+				if err := json.NewDecoder(r.Body).Decode(body); err != nil {
+					t.Error(err)
+				}
+				if body.Action != "ALLOW" || body.MsgID != "abc" || body.UserID != "123" {
+					t.Error("unexpected body:", body)
+				}
+				w.WriteHeader(http.StatusNoContent)
+			},
+		},
+		config:    &Config{Scopes: []string{"moderator:manage:automod"}},
+		tokenType: "user",
+	}
+
+	if err := client.ManageHeldAutoModMessage(&ManageHeldAutoModMessageBody{UserID: "123", MsgID: "abc", Action: "ALLOW"}); err != nil {
+		t.Error(err)
+	}
+	if err := client.ManageHeldAutoModMessage(&ManageHeldAutoModMessageBody{UserID: "123", MsgID: "abc", Action: "allow"}); err == nil {
+		t.Error("expected error for invalid action")
+	}
+}
+
+// Tests that CheckAutoModStatus limits the number of messages per call.
+func TestCheckAutoModStatusLimit(t *testing.T) {
+	cfg := &Config{Scopes: []string{"moderation:read"}}
+	client := newMockClient(cfg, "user", http.StatusOK, []byte(`{"data":[{"msg_id":"1","is_permitted":true}]}`))
+
+	resp, err := client.CheckAutoModStatus(&CheckAutoModStatusOpt{BroadcasterID: "123"}, []AutoModMessage{{MsgID: "1", MsgText: "hello"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Data) != 1 || !resp.Data[0].IsPermitted {
+		t.Error("unexpected response:", resp.Data)
+	}
+
+	if _, err := client.CheckAutoModStatus(&CheckAutoModStatusOpt{BroadcasterID: "123"}, make([]AutoModMessage, 101)); err == nil {
+		t.Error("expected error for more than 100 messages")
+	}
+}
+
+// Tests that WarnChatUser wraps the warning in a data object.
+func TestWarnChatUser(t *testing.T) {
+	client := &Client{
+		conn: &mockHTTPClient{
+			response: func(w http.ResponseWriter, r *http.Request) {
+				body := new(warnChatUserRequest)
+				if err := json.NewDecoder(r.Body).Decode(body); err != nil {
+					t.Error(err)
+				}
+				if body.Data.UserID != "789" || body.Data.Reason != "spam" {
+					t.Error("unexpected body:", body)
+				}
+				w.Write([]byte(`{"data":[{"broadcaster_id":"123","user_id":"789","moderator_id":"456","reason":"spam"}]}`))
+			},
+		},
+		config:    &Config{Scopes: []string{"moderator:manage:warnings"}},
+		tokenType: "user",
+	}
+
+	opt := &WarnChatUserOpt{BroadcasterID: "123", ModeratorID: "456"}
+	resp, err := client.WarnChatUser(opt, &WarnChatUserBody{UserID: "789", Reason: "spam"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Data) != 1 || resp.Data[0].Reason != "spam" {
+		t.Error("unexpected response:", resp.Data)
+	}
+
+	if _, err := client.WarnChatUser(opt, &WarnChatUserBody{UserID: "789"}); err == nil {
+		t.Error("expected error for missing reason")
+	}
+}
@@ -0,0 +1,82 @@
+package helix
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+const (
+	raidsPath = "/raids"
+)
+
+// StartRaidOpt defines the options available for Start a Raid.
+type StartRaidOpt struct {
+	FromBroadcasterID string `url:"from_broadcaster_id"`
+	ToBroadcasterID   string `url:"to_broadcaster_id"`
+}
+
+// StartRaidData represents a pending raid.
+type StartRaidData struct {
+	CreatedAt string `json:"created_at,omitempty"`
+	IsMature  bool   `json:"is_mature"`
+}
+
+// StartRaidResponse represents a response from a Start a Raid command.
+type StartRaidResponse struct {
+	Data []StartRaidData `json:"data,omitempty"`
+}
+
+// StartRaid raids another channel by sending the broadcaster's viewers to the targeted channel.
+// The raid starts after a 90 second countdown, or when the broadcaster confirms it.
+// Requires scope: channel:manage:raids
+//
+// https://dev.twitch.tv/docs/api/reference#start-a-raid
+func (client *Client) StartRaid(opt *StartRaidOpt) (*StartRaidResponse, error) {
+	if client.tokenType != "user" {
+		return nil, errors.New("Helix: Start a Raid endpoint requires a user token for authentication.")
+	}
+	if !client.hasScope("channel:manage:raids") {
+		return nil, errors.New("Helix: Missing required scope for Start a Raid- channel:manage:raids")
+	}
+	if opt.FromBroadcasterID == opt.ToBroadcasterID {
+		return nil, errors.New("Helix: A broadcaster cannot raid their own channel.")
+	}
+
+	data := new(StartRaidResponse)
+	resp, err := client.postRequest(raidsPath, opt)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkResponse(resp); err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(resp.Data, data)
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// CancelRaidOpt defines the options available for Cancel a Raid.
+type CancelRaidOpt struct {
+	BroadcasterID string `url:"broadcaster_id"`
+}
+
+// CancelRaid cancels a pending raid started by the broadcaster.
+// Requires scope: channel:manage:raids
+//
+// https://dev.twitch.tv/docs/api/reference#cancel-a-raid
+func (client *Client) CancelRaid(opt *CancelRaidOpt) error {
+	if client.tokenType != "user" {
+		return errors.New("Helix: Cancel a Raid endpoint requires a user token for authentication.")
+	}
+	if !client.hasScope("channel:manage:raids") {
+		return errors.New("Helix: Missing required scope for Cancel a Raid- channel:manage:raids")
+	}
+
+	resp, err := client.deleteRequest(raidsPath, opt)
+	if err != nil {
+		return err
+	}
+	return checkResponse(resp)
+}
@@ -0,0 +1,77 @@
+package helix
+
+import (
+	"net/http"
+	"testing"
+)
+
+// Tests that StartRaid checks the token and scope, rejects self raids and decodes the pending raid.
+func TestStartRaid(t *testing.T) {
+	cfg := &Config{Scopes: []string{"channel:manage:raids"}}
+	client := &Client{
+		conn: &mockHTTPClient{
+			response: func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost {
+					t.Errorf("wanted: %s\n got: %s\n", http.MethodPost, r.Method)
+				}
+				if got := r.URL.String(); got != "https://api.twitch.tv/helix/raids?from_broadcaster_id=12345678&to_broadcaster_id=87654321" {
+					t.Errorf("unexpected URL: %s", got)
+				}
+				w.Write([]byte(`{"data":[{"created_at":"2022-02-18T07:20:50.52Z","is_mature":false}]}`))
+			},
+		},
+		config:    cfg,
+		tokenType: "user",
+	}
+	opt := &StartRaidOpt{FromBroadcasterID: "12345678", ToBroadcasterID: "87654321"}
+
+	resp, err := client.StartRaid(opt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Data) != 1 || resp.Data[0].CreatedAt != "2022-02-18T07:20:50.52Z" {
+		t.Error("unexpected response:", resp.Data)
+	}
+
+	if _, err := client.StartRaid(&StartRaidOpt{FromBroadcasterID: "12345678", ToBroadcasterID: "12345678"}); err == nil {
+		t.Error("expected error for a self raid")
+	}
+	if _, err := newMockClient(cfg, "app", http.StatusOK, nil).StartRaid(opt); err == nil {
+		t.Error("expected error for an app token")
+	}
+	if _, err := newMockClient(new(Config), "user", http.StatusOK, nil).StartRaid(opt); err == nil {
+		t.Error("expected error for a missing scope")
+	}
+	client = newMockClient(cfg, "user", http.StatusTooManyRequests, []byte(`{"error":"Too Many Requests","status":429,"message":"The request exceeds the number of raids allowed"}`))
+	if _, err := client.StartRaid(opt); err == nil {
+		t.Error("expected error")
+	}
+}
+
+// Tests that CancelRaid sends a DELETE for the broadcaster and surfaces API errors.
+func TestCancelRaid(t *testing.T) {
+	cfg := &Config{Scopes: []string{"channel:manage:raids"}}
+	client := &Client{
+		conn: &mockHTTPClient{
+			response: func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodDelete {
+					t.Errorf("wanted: %s\n got: %s\n", http.MethodDelete, r.Method)
+				}
+				if got := r.URL.String(); got != "https://api.twitch.tv/helix/raids?broadcaster_id=12345678" {
+					t.Errorf("unexpected URL: %s", got)
+				}
+				w.WriteHeader(http.StatusNoContent)
+			},
+		},
+		config:    cfg,
+		tokenType: "user",
+	}
+	if err := client.CancelRaid(&CancelRaidOpt{BroadcasterID: "12345678"}); err != nil {
+		t.Error(err)
+	}
+
+	client = newMockClient(cfg, "user", http.StatusNotFound, []byte(`{"error":"Not Found","status":404,"message":"The broadcaster doesn't have a pending raid to cancel"}`))
+	if err := client.CancelRaid(&CancelRaidOpt{BroadcasterID: "12345678"}); err == nil {
+		t.Error("expected error")
+	}
+}
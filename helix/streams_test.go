@@ -0,0 +1,28 @@
+package helix
+
+import (
+	"net/http"
+	"testing"
+)
+
+// Tests that repeated query parameters are limited to 100 values.
+func TestQueryValueLimits(t *testing.T) {
+	client := newMockClient(new(Config), "app", http.StatusOK, []byte(`{"data":[]}`))
+	tooMany := make([]string, MaxQueryValues+1)
+
+	if _, err := client.GetStreams(&GetStreamsOpt{UserLogin: tooMany}); err == nil {
+		t.Error("expected error for too many user logins")
+	}
+	if _, err := client.GetGames(&GetGamesOpt{Name: tooMany}); err == nil {
+		t.Error("expected error for too many game names")
+	}
+	if _, err := client.GetClips(&GetClipsOpt{ID: tooMany}); err == nil {
+		t.Error("expected error for too many clip IDs")
+	}
+	if _, err := client.GetVideos(&GetVideosOpt{ID: tooMany}); err == nil {
+		t.Error("expected error for too many video IDs")
+	}
+	if _, err := client.GetStreams(&GetStreamsOpt{UserID: tooMany[:MaxQueryValues]}); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,111 @@
+package helix
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+const (
+	getTeamsPath        = "/teams"
+	getChannelTeamsPath = "/teams/channel"
+)
+
+// GetTeamsOpt defines the options available for Get Teams. Exactly one of Name or ID must be provided.
+type GetTeamsOpt struct {
+	Name string `url:"name,omitempty"`
+	ID   string `url:"id,omitempty"`
+}
+
+// TeamMember represents a user that is a member of a team.
+type TeamMember struct {
+	UserID    string `json:"user_id,omitempty"`
+	UserLogin string `json:"user_login,omitempty"`
+	UserName  string `json:"user_name,omitempty"`
+}
+
+// GetTeamsData represents information about a team and its members.
+type GetTeamsData struct {
+	ID                 string       `json:"id,omitempty"`
+	TeamName           string       `json:"team_name,omitempty"`
+	TeamDisplayName    string       `json:"team_display_name,omitempty"`
+	Info               string       `json:"info,omitempty"`
+	BackgroundImageURL string       `json:"background_image_url,omitempty"`
+	Banner             string       `json:"banner,omitempty"`
+	ThumbnailURL       string       `json:"thumbnail_url,omitempty"`
+	CreatedAt          string       `json:"created_at,omitempty"`
+	UpdatedAt          string       `json:"updated_at,omitempty"`
+	Users              []TeamMember `json:"users,omitempty"`
+}
+
+// GetTeamsResponse represents a response from a Get Teams command.
+type GetTeamsResponse struct {
+	Data []GetTeamsData `json:"data,omitempty"`
+}
+
+// GetTeams returns information about a team, including its members.
+//
+// https://dev.twitch.tv/docs/api/reference#get-teams
+func (client *Client) GetTeams(opt *GetTeamsOpt) (*GetTeamsResponse, error) {
+	if opt == nil || (opt.Name == "") == (opt.ID == "") {
+		return nil, errors.New("Helix: Get Teams requires exactly one of a team name or ID.")
+	}
+
+	data := new(GetTeamsResponse)
+	resp, err := client.getRequest(getTeamsPath, opt)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkResponse(resp); err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(resp.Data, data)
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// GetChannelTeamsOpt defines the options available for Get Channel Teams.
+type GetChannelTeamsOpt struct {
+	BroadcasterID string `url:"broadcaster_id"`
+}
+
+// GetChannelTeamsData represents a team that a broadcaster is a member of.
+type GetChannelTeamsData struct {
+	BroadcasterID      string `json:"broadcaster_id,omitempty"`
+	BroadcasterLogin   string `json:"broadcaster_login,omitempty"`
+	BroadcasterName    string `json:"broadcaster_name,omitempty"`
+	ID                 string `json:"id,omitempty"`
+	TeamName           string `json:"team_name,omitempty"`
+	TeamDisplayName    string `json:"team_display_name,omitempty"`
+	Info               string `json:"info,omitempty"`
+	BackgroundImageURL string `json:"background_image_url,omitempty"`
+	Banner             string `json:"banner,omitempty"`
+	ThumbnailURL       string `json:"thumbnail_url,omitempty"`
+	CreatedAt          string `json:"created_at,omitempty"`
+	UpdatedAt          string `json:"updated_at,omitempty"`
+}
+
+// GetChannelTeamsResponse represents a response from a Get Channel Teams command.
+type GetChannelTeamsResponse struct {
+	Data []GetChannelTeamsData `json:"data,omitempty"`
+}
+
+// GetChannelTeams returns the teams that a broadcaster is a member of.
+//
+// https://dev.twitch.tv/docs/api/reference#get-channel-teams
+func (client *Client) GetChannelTeams(opt *GetChannelTeamsOpt) (*GetChannelTeamsResponse, error) {
+	data := new(GetChannelTeamsResponse)
+	resp, err := client.getRequest(getChannelTeamsPath, opt)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkResponse(resp); err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(resp.Data, data)
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
@@ -0,0 +1,53 @@
+package helix
+
+import (
+	"net/http"
+	"testing"
+)
+
+// Tests that GetTeams requires exactly one of a name or ID and decodes the team members.
+func TestGetTeams(t *testing.T) {
+	client := &Client{
+		conn: &mockHTTPClient{
+			response: func(w http.ResponseWriter, r *http.Request) {
+				if got := r.URL.String(); got != "https://api.twitch.tv/helix/teams?name=staff" {
+					t.Errorf("unexpected URL: %s", got)
+				}
+				w.Write([]byte(`{"data":[{"id":"6358","team_name":"staff","team_display_name":"Twitch Staff","users":[{"user_id":"278217731","user_login":"mastermndio","user_name":"mastermndio"},{"user_id":"41284990","user_login":"jenninexus","user_name":"JenniNexus"}]}]}`))
+			},
+		},
+		config:    new(Config),
+		tokenType: "app",
+	}
+
+	for _, opt := range []*GetTeamsOpt{nil, {}, {Name: "staff", ID: "6358"}} {
+		if _, err := client.GetTeams(opt); err == nil {
+			t.Errorf("expected error for %v", opt)
+		}
+	}
+
+	resp, err := client.GetTeams(&GetTeamsOpt{Name: "staff"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Data) != 1 || len(resp.Data[0].Users) != 2 || resp.Data[0].Users[1].UserLogin != "jenninexus" {
+		t.Error("unexpected response:", resp.Data)
+	}
+}
+
+// Tests that GetChannelTeams decodes the teams of a broadcaster and surfaces API errors.
+func TestGetChannelTeams(t *testing.T) {
+	client := newMockClient(new(Config), "app", http.StatusOK, []byte(`{"data":[{"broadcaster_id":"96909659","broadcaster_login":"csharpfritz","id":"6358","team_name":"livecoders"}]}`))
+	resp, err := client.GetChannelTeams(&GetChannelTeamsOpt{BroadcasterID: "96909659"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Data) != 1 || resp.Data[0].TeamName != "livecoders" {
+		t.Error("unexpected response:", resp.Data)
+	}
+
+	client = newMockClient(new(Config), "app", http.StatusBadRequest, []byte(`{"error":"Bad Request","status":400,"message":"Missing required parameter"}`))
+	if _, err := client.GetChannelTeams(&GetChannelTeamsOpt{}); err == nil {
+		t.Error("expected error")
+	}
+}
@@ -3,6 +3,7 @@ package helix
 import (
 	"encoding/json"
 	"errors"
+	"net/http"
 	"time"
 )
 
@@ -12,6 +13,7 @@ const (
 	getUsersExtensionsPath       = "/users/extensions/list"
 	getUsersActiveExtensionsPath = "/users/extensions"
 	getModsPath                  = "/moderation/moderators"
+	userBlocksPath               = "/users/blocks"
 )
 
 // PaginationData represents the current ID for a multi-page response.
@@ -92,6 +94,8 @@ type GetUsersFollowsResponse struct {
 // GetUsersFollows obtains information about who a user is following or who follows a user.
 // Returns a GetUsersFollowsResponse constructed from the response from the API endpoint.
 //
+// Deprecated: Twitch has retired this endpoint. Use GetChannelFollowers or GetFollowedChannels instead.
+//
 // https://dev.twitch.tv/docs/api/reference#get-users-follows
 func (client *Client) GetUsersFollows(opt *GetUsersFollowsOpt) (*GetUsersFollowsResponse, error) {
 	data := new(GetUsersFollowsResponse)
@@ -224,6 +228,45 @@ func (client *Client) GetUserActiveExtensions(opt *GetUserActiveExtensionsOpt) (
 	return data, nil
 }
 
+// UpdateUserExtensionsBody represents the request body of an Update User Extensions command.
+type UpdateUserExtensionsBody struct {
+	Data GetUserActiveExtensionsData `json:"data"`
+}
+
+// UpdateUserExtensions updates the activation state, extension ID and version number of the installed extensions
+// for the user identified by the user token. Only the slots present in data are changed.
+// Returns the updated active extensions constructed from the response from the API endpoint.
+// Requires scope user:edit:broadcast
+//
+// https://dev.twitch.tv/docs/api/reference#update-user-extensions
+func (client *Client) UpdateUserExtensions(data *GetUserActiveExtensionsData) (*GetUserActiveExtensionsResponse, error) {
+	if client.tokenType != "user" {
+		return nil, errors.New("Helix: Update User Extensions endpoint requires a user token for authentication.")
+	}
+	if !client.hasScope("user:edit:broadcast") {
+		return nil, errors.New("Helix: Missing required scope for Update User Extensions- user:edit:broadcast")
+	}
+	if data == nil {
+		return nil, errors.New("Helix: Update User Extensions requires the extensions to activate.")
+	}
+
+	result := new(GetUserActiveExtensionsResponse)
+	resp, err := client.jsonRequest(getUsersActiveExtensionsPath, nil, &UpdateUserExtensionsBody{Data: *data}, http.MethodPut)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkResponse(resp); err != nil {
+		return nil, err
+	}
+
+	// Decode the response
+	err = json.Unmarshal(resp.Data, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 // GetModsOpt defines the options available for Get Moderators.
 type GetModsOpt struct {
 	BroadcasterId string `url:"broadcaster_id,omitempty"`
@@ -263,3 +306,116 @@ func (client *Client) GetModerators(opt *GetModsOpt) (*GetModsResponse, error) {
 
 	return data, nil
 }
+
+// GetUserBlockListOpt defines the options available for Get User Block List.
+type GetUserBlockListOpt struct {
+	BroadcasterID string `url:"broadcaster_id"`
+	First         int    `url:"first,omitempty"`
+	After         string `url:"after,omitempty"`
+}
+
+// GetUserBlockListData represents a user blocked by the broadcaster.
+type GetUserBlockListData struct {
+	UserID      string `json:"user_id,omitempty"`
+	UserLogin   string `json:"user_login,omitempty"`
+	DisplayName string `json:"display_name,omitempty"`
+}
+
+// GetUserBlockListResponse represents a response from a Get User Block List command.
+type GetUserBlockListResponse struct {
+	Data       []GetUserBlockListData `json:"data,omitempty"`
+	Pagination PaginationData         `json:"pagination,omitempty"`
+}
+
+// GetUserBlockList returns a page of the users that the broadcaster has blocked.
+// Requires scope: user:read:blocked_users
+//
+// https://dev.twitch.tv/docs/api/reference#get-user-block-list
+func (client *Client) GetUserBlockList(opt *GetUserBlockListOpt) (*GetUserBlockListResponse, error) {
+	if client.tokenType != "user" {
+		return nil, errors.New("Helix: Get User Block List endpoint requires a user token for authentication.")
+	}
+	if !client.hasScope("user:read:blocked_users") {
+		return nil, errors.New("Helix: Missing required scope for Get User Block List- user:read:blocked_users")
+	}
+
+	data := new(GetUserBlockListResponse)
+	resp, err := client.getRequest(userBlocksPath, opt)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkResponse(resp); err != nil {
+		return nil, err
+	}
+
+	// Decode the response
+	err = json.Unmarshal(resp.Data, data)
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// BlockUserOpt defines the options available for Block User.
+// SourceContext may be chat or whisper. Reason may be harassment, spam or other.
+type BlockUserOpt struct {
+	TargetUserID  string `url:"target_user_id"`
+	SourceContext string `url:"source_context,omitempty"`
+	Reason        string `url:"reason,omitempty"`
+}
+
+// BlockUser blocks the target user on behalf of the user of the access token.
+// Requires scope: user:manage:blocked_users
+//
+// https://dev.twitch.tv/docs/api/reference#block-user
+func (client *Client) BlockUser(opt *BlockUserOpt) error {
+	if client.tokenType != "user" {
+		return errors.New("Helix: Block User endpoint requires a user token for authentication.")
+	}
+	if !client.hasScope("user:manage:blocked_users") {
+		return errors.New("Helix: Missing required scope for Block User- user:manage:blocked_users")
+	}
+	if opt == nil || opt.TargetUserID == "" {
+		return errors.New("Helix: Block User requires a target user ID.")
+	}
+	switch opt.SourceContext {
+	case "", "chat", "whisper":
+	default:
+		return errors.New("Helix: Block User source context must be chat or whisper.")
+	}
+	switch opt.Reason {
+	case "", "harassment", "spam", "other":
+	default:
+		return errors.New("Helix: Block User reason must be harassment, spam or other.")
+	}
+
+	resp, err := client.putRequest(userBlocksPath, opt)
+	if err != nil {
+		return err
+	}
+	return checkResponse(resp)
+}
+
+// UnblockUserOpt defines the options available for Unblock User.
+type UnblockUserOpt struct {
+	TargetUserID string `url:"target_user_id"`
+}
+
+// UnblockUser removes the target user from the block list of the user of the access token.
+// Requires scope: user:manage:blocked_users
+//
+// https://dev.twitch.tv/docs/api/reference#unblock-user
+func (client *Client) UnblockUser(opt *UnblockUserOpt) error {
+	if client.tokenType != "user" {
+		return errors.New("Helix: Unblock User endpoint requires a user token for authentication.")
+	}
+	if !client.hasScope("user:manage:blocked_users") {
+		return errors.New("Helix: Missing required scope for Unblock User- user:manage:blocked_users")
+	}
+
+	resp, err := client.deleteRequest(userBlocksPath, opt)
+	if err != nil {
+		return err
+	}
+	return checkResponse(resp)
+}
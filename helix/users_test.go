@@ -62,3 +62,82 @@ func TestGetUsers(t *testing.T) {
 		t.Error("decoded struct does not match input struct")
 	}
 }
+
+// Tests that the block list endpoints check the token type and scopes and build the expected requests.
+func TestUserBlockList(t *testing.T) {
+	cfg := &Config{Scopes: []string{"user:read:blocked_users", "user:manage:blocked_users"}}
+	var method, query string
+	client := &Client{
+		conn: &mockHTTPClient{
+			response: func(w http.ResponseWriter, r *http.Request) {
+				method = r.Method
+				query = r.URL.RawQuery
+				if r.Method == http.MethodGet {
+					w.Write([]byte(`{"data":[{"user_id":"135093069","user_login":"bluelava","display_name":"BlueLava"}],"pagination":{"cursor":"abc"}}`))
+					return
+				}
+				w.WriteHeader(http.StatusNoContent)
+			},
+		},
+		config:    cfg,
+		tokenType: "user",
+	}
+
+	resp, err := client.GetUserBlockList(&GetUserBlockListOpt{BroadcasterID: "141981764", First: 100})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Data) != 1 || resp.Data[0].UserLogin != "bluelava" || resp.Pagination.Cursor != "abc" {
+		t.Error("unexpected response:", resp)
+	}
+
+	if err := client.BlockUser(&BlockUserOpt{TargetUserID: "198704263", Reason: "spam"}); err != nil {
+		t.Error(err)
+	}
+	if method != http.MethodPut || query != "reason=spam&target_user_id=198704263" {
+		t.Errorf("unexpected block request: %s %s", method, query)
+	}
+
+	if err := client.UnblockUser(&UnblockUserOpt{TargetUserID: "198704263"}); err != nil {
+		t.Error(err)
+	}
+	if method != http.MethodDelete || query != "target_user_id=198704263" {
+		t.Errorf("unexpected unblock request: %s %s", method, query)
+	}
+
+	if err := client.BlockUser(&BlockUserOpt{TargetUserID: "198704263", Reason: "rude"}); err == nil {
+		t.Error("expected error for invalid reason")
+	}
+	for _, opt := range []*BlockUserOpt{nil, {Reason: "spam"}} {
+		if err := client.BlockUser(opt); err == nil {
+			t.Errorf("expected error for %v", opt)
+		}
+	}
+	if err := client.BlockUser(&BlockUserOpt{TargetUserID: "198704263", SourceContext: "stream"}); err == nil {
+		t.Error("expected error for invalid source context")
+	}
+
+	noScopes := newMockClient(new(Config), "user", http.StatusNoContent, nil)
+	if _, err := noScopes.GetUserBlockList(&GetUserBlockListOpt{BroadcasterID: "141981764"}); err == nil {
+		t.Error("expected error for missing scope")
+	}
+	if err := noScopes.UnblockUser(&UnblockUserOpt{TargetUserID: "198704263"}); err == nil {
+		t.Error("expected error for missing scope")
+	}
+	app := newMockClient(cfg, "app", http.StatusNoContent, nil)
+	if err := app.BlockUser(&BlockUserOpt{TargetUserID: "198704263"}); err == nil {
+		t.Error("expected error for app token")
+	}
+}
+
+// Tests that UpdateUserExtensions rejects missing extension data instead of sending an empty update.
+func TestUpdateUserExtensionsNil(t *testing.T) {
+	cfg := &Config{Scopes: []string{"user:edit:broadcast"}}
+	client := newMockClient(cfg, "user", http.StatusOK, []byte(`{"data":{}}`))
+	if _, err := client.UpdateUserExtensions(nil); err == nil {
+		t.Error("expected error for nil data")
+	}
+	if _, err := client.UpdateUserExtensions(&GetUserActiveExtensionsData{}); err != nil {
+		t.Error(err)
+	}
+}
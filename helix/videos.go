@@ -2,6 +2,8 @@ package helix
 
 import (
 	"encoding/json"
+	"errors"
+	"time"
 )
 
 const (
@@ -10,34 +12,90 @@ const (
 
 // GetVideosOpt defines the options available for Get Videos.
 type GetVideosOpt struct {
-	ID       string `url:"id,omitempty"`
-	UserID   string `url:"user_id,omitempty"`
-	GameID   string `url:"game_id,omitempty"`
-	After    string `url:"after,omitempty"`
-	Before   string `url:"before,omitempty"`
-	First    string `url:"first,omitempty"`
-	Language string `url:"language,omitempty"`
-	Period   string `url:"period,omitempty"`
-	Sort     string `url:"sort,omitempty"`
-	Type     string `url:"type,omitempty"`
+	ID       []string `url:"id,omitempty"`
+	UserID   string   `url:"user_id,omitempty"`
+	GameID   string   `url:"game_id,omitempty"`
+	After    string   `url:"after,omitempty"`
+	Before   string   `url:"before,omitempty"`
+	First    int      `url:"first,omitempty"`
+	Language string   `url:"language,omitempty"`
+	Period   string   `url:"period,omitempty"`
+	Sort     string   `url:"sort,omitempty"`
+	Type     string   `url:"type,omitempty"`
+}
+
+// MutedSegment represents a segment of a video that was muted for copyrighted audio.
+// Duration and Offset are in seconds.
+type MutedSegment struct {
+	Duration int `json:"duration"`
+	Offset   int `json:"offset"`
 }
 
 // GetVideosData represents metadata about a video.
+// Duration is parsed from the Twitch duration format, for example 3h21m5s.
 type GetVideosData struct {
-	CreatedAt    string `json:"created_at,omitempty"`
-	Description  string `json:"description,omitempty"`
-	Duration     string `json:"duration,omitempty"`
-	ID           string `json:"id,omitempty"`
-	Language     string `json:"language,omitempty"`
-	PublishedAt  string `json:"published_at,omitempty"`
-	ThumbnailURL string `json:"thumbnail_url,omitempty"`
-	Title        string `json:"title,omitempty"`
-	Type         string `json:"type,omitempty"`
-	URL          string `json:"url,omitempty"`
-	UserID       string `json:"user_id,omitempty"`
-	UserName     string `json:"user_name,omitempty"`
-	ViewCount    int    `json:"view_count,omitempty"`
-	Viewable     string `json:"viewable,omitempty"`
+	CreatedAt     time.Time      `json:"created_at,omitempty"`
+	Description   string         `json:"description,omitempty"`
+	Duration      time.Duration  `json:"duration,omitempty"`
+	ID            string         `json:"id,omitempty"`
+	Language      string         `json:"language,omitempty"`
+	MutedSegments []MutedSegment `json:"muted_segments,omitempty"`
+	PublishedAt   time.Time      `json:"published_at,omitempty"`
+	StreamID      string         `json:"stream_id,omitempty"`
+	ThumbnailURL  string         `json:"thumbnail_url,omitempty"`
+	Title         string         `json:"title,omitempty"`
+	Type          string         `json:"type,omitempty"`
+	URL           string         `json:"url,omitempty"`
+	UserID        string         `json:"user_id,omitempty"`
+	UserLogin     string         `json:"user_login,omitempty"`
+	UserName      string         `json:"user_name,omitempty"`
+	ViewCount     int            `json:"view_count,omitempty"`
+	Viewable      string         `json:"viewable,omitempty"`
+}
+
+// Alias of GetVideosData without its JSON methods, used to decode everything except the duration.
+type getVideosDataAlias GetVideosData
+
+type getVideosDataJSON struct {
+	*getVideosDataAlias
+	Duration string  `json:"duration,omitempty"`
+	StreamID *string `json:"stream_id"`
+}
+
+// UnmarshalJSON decodes a video, converting the Twitch duration string into a time.Duration.
+func (d *GetVideosData) UnmarshalJSON(b []byte) error {
+	tmp := &getVideosDataJSON{getVideosDataAlias: (*getVideosDataAlias)(d)}
+	err := json.Unmarshal(b, tmp)
+	if err != nil {
+		return err
+	}
+
+	// Videos that are not archives of a stream have a null stream ID.
+	d.StreamID = ""
+	if tmp.StreamID != nil {
+		d.StreamID = *tmp.StreamID
+	}
+
+	d.Duration = 0
+	if tmp.Duration != "" {
+		d.Duration, err = time.ParseDuration(tmp.Duration)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MarshalJSON encodes a video with its duration in the Twitch duration format.
+func (d GetVideosData) MarshalJSON() ([]byte, error) {
+	tmp := &getVideosDataJSON{getVideosDataAlias: (*getVideosDataAlias)(&d)}
+	if d.Duration != 0 {
+		tmp.Duration = d.Duration.String()
+	}
+	if d.StreamID != "" {
+		tmp.StreamID = &d.StreamID
+	}
+	return json.Marshal(tmp)
 }
 
 // GetVideosResponse represents the response from a Get Videos command.
@@ -47,9 +105,16 @@ type GetVideosResponse struct {
 }
 
 // GetVideos gets information by vodep id, user id or game id.
+// Up to 100 video IDs may be provided.
 //
 // https://dev.twitch.tv/docs/api/reference/#get-videos
 func (client *Client) GetVideos(opt *GetVideosOpt) (*GetVideosResponse, error) {
+	if opt != nil {
+		if err := checkQueryValues("id", opt.ID); err != nil {
+			return nil, err
+		}
+	}
+
 	data := new(GetVideosResponse)
 	resp, err := client.getRequest(getVideosPath, opt)
 	if err != nil {
@@ -61,3 +126,48 @@ func (client *Client) GetVideos(opt *GetVideosOpt) (*GetVideosResponse, error) {
 	}
 	return data, nil
 }
+
+// DeleteVideosOpt defines the options available for Delete Videos.
+type DeleteVideosOpt struct {
+	ID []string `url:"id"`
+}
+
+// DeleteVideosResponse represents the response from a Delete Videos command.
+// Data contains the IDs of the videos that were deleted.
+type DeleteVideosResponse struct {
+	Data []string `json:"data,omitempty"`
+}
+
+// DeleteVideos deletes up to 5 videos owned by the user of the access token.
+// Returns the IDs of the videos that were deleted. If any video cannot be deleted, none are deleted.
+// Requires scope: channel:manage:videos
+//
+// https://dev.twitch.tv/docs/api/reference#delete-videos
+func (client *Client) DeleteVideos(opt *DeleteVideosOpt) (*DeleteVideosResponse, error) {
+	if client.tokenType != "user" {
+		return nil, errors.New("Helix: Delete Videos endpoint requires a user token for authentication.")
+	}
+	if !client.hasScope("channel:manage:videos") {
+		return nil, errors.New("Helix: Missing required scope for Delete Videos- channel:manage:videos")
+	}
+	if opt == nil || len(opt.ID) == 0 {
+		return nil, errors.New("Helix: Delete Videos requires at least one video ID.")
+	}
+	if len(opt.ID) > 5 {
+		return nil, errors.New("Helix: Cannot delete more than 5 videos per call.")
+	}
+
+	data := new(DeleteVideosResponse)
+	resp, err := client.deleteRequest(getVideosPath, opt)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkResponse(resp); err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(resp.Data, data)
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
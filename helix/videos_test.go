@@ -0,0 +1,66 @@
+package helix
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// Tests that GetVideos decodes timestamps, durations, muted segments and null stream IDs.
+func TestGetVideos(t *testing.T) {
+	client := newMockClient(new(Config), "app", http.StatusOK, []byte(`{"data":[
+		{"id":"1","stream_id":"42","created_at":"2020-06-01T10:00:00Z","published_at":"2020-06-01T10:00:00Z","duration":"3h21m5s","muted_segments":[{"duration":30,"offset":120}]},
+		{"id":"2","stream_id":null,"created_at":"2020-06-02T10:00:00Z","published_at":"2020-06-02T10:00:00Z","duration":"45s","muted_segments":null}
+	],"pagination":{}}`))
+
+	resp, err := client.GetVideos(&GetVideosOpt{UserID: "123"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []GetVideosData{
+		{
+			ID:            "1",
+			StreamID:      "42",
+			CreatedAt:     time.Date(2020, 6, 1, 10, 0, 0, 0, time.UTC),
+			PublishedAt:   time.Date(2020, 6, 1, 10, 0, 0, 0, time.UTC),
+			Duration:      3*time.Hour + 21*time.Minute + 5*time.Second,
+			MutedSegments: []MutedSegment{{Duration: 30, Offset: 120}},
+		},
+		{
+			ID:          "2",
+			CreatedAt:   time.Date(2020, 6, 2, 10, 0, 0, 0, time.UTC),
+			PublishedAt: time.Date(2020, 6, 2, 10, 0, 0, 0, time.UTC),
+			Duration:    45 * time.Second,
+		},
+	}
+	if !cmp.Equal(want, resp.Data) {
+		t.Error(cmp.Diff(want, resp.Data))
+	}
+}
+
+// Tests that DeleteVideos validates the number of IDs and the required scope.
+func TestDeleteVideos(t *testing.T) {
+	cfg := &Config{Scopes: []string{"channel:manage:videos"}}
+	client := newMockClient(cfg, "user", http.StatusOK, []byte(`{"data":["1","2"]}`))
+
+	resp, err := client.DeleteVideos(&DeleteVideosOpt{ID: []string{"1", "2"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !cmp.Equal([]string{"1", "2"}, resp.Data) {
+		t.Error("unexpected deleted IDs:", resp.Data)
+	}
+
+	if _, err := client.DeleteVideos(nil); err == nil {
+		t.Error("expected error for nil options")
+	}
+	if _, err := client.DeleteVideos(&DeleteVideosOpt{ID: []string{"1", "2", "3", "4", "5", "6"}}); err == nil {
+		t.Error("expected error for more than 5 IDs")
+	}
+	if _, err := newMockClient(new(Config), "user", http.StatusOK, nil).DeleteVideos(&DeleteVideosOpt{ID: []string{"1"}}); err == nil {
+		t.Error("expected error for missing scope")
+	}
+}
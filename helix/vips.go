@@ -0,0 +1,138 @@
+package helix
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+)
+
+const (
+	channelVIPsPath = "/channels/vips"
+)
+
+var (
+	// ErrNoVIPSlots is returned by AddChannelVIP when the broadcaster has no VIP slots available.
+	ErrNoVIPSlots = errors.New("Helix: The broadcaster has no available VIP slots.")
+
+	// ErrAlreadyVIP is returned by AddChannelVIP when the user is already a VIP.
+	ErrAlreadyVIP = errors.New("Helix: The user is already a VIP.")
+
+	// ErrVIPUnavailable is returned by AddChannelVIP when the broadcaster has not unlocked VIPs yet.
+	ErrVIPUnavailable = errors.New("Helix: The broadcaster must complete the Build a Community requirement before adding VIPs.")
+
+	// ErrNotVIP is returned by RemoveChannelVIP when the user is not a VIP.
+	ErrNotVIP = errors.New("Helix: The user is not a VIP.")
+)
+
+// GetVIPsOpt defines the options available for Get VIPs.
+// Up to 100 user IDs may be provided to check whether those users are VIPs.
+type GetVIPsOpt struct {
+	BroadcasterID string   `url:"broadcaster_id"`
+	UserID        []string `url:"user_id,omitempty"`
+	First         int      `url:"first,omitempty"`
+	After         string   `url:"after,omitempty"`
+}
+
+// GetVIPsData represents a VIP of a channel.
+type GetVIPsData struct {
+	UserID    string `json:"user_id,omitempty"`
+	UserLogin string `json:"user_login,omitempty"`
+	UserName  string `json:"user_name,omitempty"`
+}
+
+// GetVIPsResponse represents a response from a Get VIPs command.
+type GetVIPsResponse struct {
+	Data       []GetVIPsData  `json:"data,omitempty"`
+	Pagination PaginationData `json:"pagination,omitempty"`
+}
+
+// GetVIPs returns a page of the broadcaster's VIPs.
+// Requires scope: channel:read:vips or channel:manage:vips
+//
+// https://dev.twitch.tv/docs/api/reference#get-vips
+func (client *Client) GetVIPs(opt *GetVIPsOpt) (*GetVIPsResponse, error) {
+	if client.tokenType != "user" {
+		return nil, errors.New("Helix: Get VIPs endpoint requires a user token for authentication.")
+	}
+	if !client.hasScope("channel:read:vips") && !client.hasScope("channel:manage:vips") {
+		return nil, errors.New("Helix: Missing required scope for Get VIPs- channel:read:vips or channel:manage:vips")
+	}
+	if opt == nil || opt.BroadcasterID == "" {
+		return nil, errors.New("Helix: Get VIPs requires a broadcaster ID.")
+	}
+	if err := checkQueryValues("user_id", opt.UserID); err != nil {
+		return nil, err
+	}
+
+	data := new(GetVIPsResponse)
+	resp, err := client.getRequest(channelVIPsPath, opt)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkResponse(resp); err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(resp.Data, data)
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// ChannelVIPOpt defines the options available for Add Channel VIP and Remove Channel VIP.
+type ChannelVIPOpt struct {
+	BroadcasterID string `url:"broadcaster_id"`
+	UserID        string `url:"user_id"`
+}
+
+// AddChannelVIP makes a user a VIP in the broadcaster's channel.
+// Returns ErrNoVIPSlots if all of the broadcaster's VIP slots are in use, ErrAlreadyVIP if the user
+// is already a VIP and ErrVIPUnavailable if the broadcaster has not unlocked VIPs.
+// Requires scope: channel:manage:vips
+//
+// https://dev.twitch.tv/docs/api/reference#add-channel-vip
+func (client *Client) AddChannelVIP(opt *ChannelVIPOpt) error {
+	if client.tokenType != "user" {
+		return errors.New("Helix: Add Channel VIP endpoint requires a user token for authentication.")
+	}
+	if !client.hasScope("channel:manage:vips") {
+		return errors.New("Helix: Missing required scope for Add Channel VIP- channel:manage:vips")
+	}
+
+	resp, err := client.postRequest(channelVIPsPath, opt)
+	if err != nil {
+		return err
+	}
+	switch resp.Status {
+	case http.StatusConflict:
+		return ErrNoVIPSlots
+	case http.StatusUnprocessableEntity:
+		return ErrAlreadyVIP
+	case http.StatusTooEarly:
+		return ErrVIPUnavailable
+	}
+	return checkResponse(resp)
+}
+
+// RemoveChannelVIP removes a user's VIP status in the broadcaster's channel.
+// Returns ErrNotVIP if the user is not a VIP.
+// Requires scope: channel:manage:vips
+//
+// https://dev.twitch.tv/docs/api/reference#remove-channel-vip
+func (client *Client) RemoveChannelVIP(opt *ChannelVIPOpt) error {
+	if client.tokenType != "user" {
+		return errors.New("Helix: Remove Channel VIP endpoint requires a user token for authentication.")
+	}
+	if !client.hasScope("channel:manage:vips") {
+		return errors.New("Helix: Missing required scope for Remove Channel VIP- channel:manage:vips")
+	}
+
+	resp, err := client.deleteRequest(channelVIPsPath, opt)
+	if err != nil {
+		return err
+	}
+	if resp.Status == http.StatusUnprocessableEntity {
+		return ErrNotVIP
+	}
+	return checkResponse(resp)
+}
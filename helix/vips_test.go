@@ -0,0 +1,45 @@
+package helix
+
+import (
+	"net/http"
+	"testing"
+)
+
+// Tests that AddChannelVIP maps VIP specific status codes to their errors.
+func TestAddChannelVIPErrors(t *testing.T) {
+	cfg := &Config{Scopes: []string{"channel:manage:vips"}}
+	cases := []struct {
+		status   int
+		expected error
+	}{
+		{http.StatusNoContent, nil},
+		{http.StatusConflict, ErrNoVIPSlots},
+		{http.StatusUnprocessableEntity, ErrAlreadyVIP},
+		{http.StatusTooEarly, ErrVIPUnavailable},
+	}
+
+	for _, c := range cases {
+		client := newMockClient(cfg, "user", c.status, nil)
+		err := client.AddChannelVIP(&ChannelVIPOpt{BroadcasterID: "123", UserID: "456"})
+		if err != c.expected {
+			t.Errorf("wanted: %v\n got: %v\n", c.expected, err)
+		}
+	}
+
+	client := newMockClient(cfg, "user", http.StatusBadRequest, []byte(`{"error":"Bad Request","status":400,"message":"Missing user_id"}`))
+	err := client.AddChannelVIP(&ChannelVIPOpt{BroadcasterID: "123"})
+	if apiErr, ok := err.(*APIError); !ok || apiErr.Message != "Missing user_id" {
+		t.Error("expected APIError, got:", err)
+	}
+}
+
+// Tests that GetVIPs rejects missing options instead of sending a request.
+func TestGetVIPsRequiresBroadcaster(t *testing.T) {
+	client := newMockClient(&Config{Scopes: []string{"channel:read:vips"}}, "user", http.StatusOK, []byte(`{"data":[]}`))
+	if _, err := client.GetVIPs(nil); err == nil {
+		t.Error("expected error for nil options")
+	}
+	if _, err := client.GetVIPs(&GetVIPsOpt{}); err == nil {
+		t.Error("expected error for missing broadcaster ID")
+	}
+}
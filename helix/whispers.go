@@ -0,0 +1,63 @@
+package helix
+
+import (
+	"errors"
+	"net/http"
+	"unicode/utf8"
+)
+
+const (
+	sendWhisperPath = "/whispers"
+
+	// WhisperMaxLength is the maximum length of a whisper to a user who has whispered the sender before.
+	WhisperMaxLength = 10000
+
+	// WhisperMaxLengthNewRecipient is the maximum length of a whisper to a user who has not
+	// whispered the sender before. Twitch truncates longer messages to this length.
+	WhisperMaxLengthNewRecipient = 500
+)
+
+// SendWhisperOpt defines the options available for Send Whisper.
+type SendWhisperOpt struct {
+	FromUserID string `url:"from_user_id"`
+	ToUserID   string `url:"to_user_id"`
+}
+
+type sendWhisperBody struct {
+	Message string `json:"message"`
+}
+
+// SendWhisper sends a whisper message from the user identified by fromUserID to the user identified by toUserID.
+// The sending user must match the user access token and must have a verified phone number.
+// Messages longer than WhisperMaxLength are rejected before being sent.
+// Requires scope: user:manage:whispers
+//
+// https://dev.twitch.tv/docs/api/reference#send-whisper
+func (client *Client) SendWhisper(fromUserID string, toUserID string, message string) error {
+	if client.tokenType != "user" {
+		return errors.New("Helix: Send Whisper endpoint requires a user token for authentication.")
+	}
+	if !client.hasScope("user:manage:whispers") {
+		return errors.New("Helix: Missing required scope for Send Whisper- user:manage:whispers")
+	}
+	if fromUserID == "" || toUserID == "" {
+		return errors.New("Helix: Send Whisper requires both a sender and recipient user ID.")
+	}
+	if message == "" {
+		return errors.New("Helix: Cannot send an empty whisper.")
+	}
+	if utf8.RuneCountInString(message) > WhisperMaxLength {
+		return errors.New("Helix: Whisper exceeds the maximum length of 10000 characters.")
+	}
+
+	opt := &SendWhisperOpt{
+		FromUserID: fromUserID,
+		ToUserID:   toUserID,
+	}
+
+	resp, err := client.jsonRequest(sendWhisperPath, opt, &sendWhisperBody{Message: message}, http.MethodPost)
+	if err != nil {
+		return err
+	}
+	return checkResponse(resp)
+}
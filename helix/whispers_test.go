@@ -0,0 +1,59 @@
+package helix
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// Tests that SendWhisper builds the expected request and succeeds on a 204 response.
+func TestSendWhisper(t *testing.T) {
+	client := &Client{
+		conn: &mockHTTPClient{
+			response: func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost {
+					t.Errorf("wanted: %s\n got: %s\n", http.MethodPost, r.Method)
+				}
+				if got := r.URL.String(); got != "https://api.twitch.tv/helix/whispers?from_user_id=123&to_user_id=456" {
+					t.Errorf("unexpected URL: %s", got)
+				}
+				body := new(sendWhisperBody)
+				if err := json.NewDecoder(r.Body).Decode(body); err != nil {
+					t.Error(err)
+				}
+				if body.Message != "hello" {
+					t.Errorf("wanted: hello\n got: %s\n", body.Message)
+				}
+				w.WriteHeader(http.StatusNoContent)
+			},
+		},
+		config:    &Config{Scopes: []string{"user:manage:whispers"}},
+		tokenType: "user",
+	}
+
+	if err := client.SendWhisper("123", "456", "hello"); err != nil {
+		t.Error(err)
+	}
+}
+
+// Tests that invalid whispers are rejected before a request is made and API errors are surfaced.
+func TestSendWhisperErrors(t *testing.T) {
+	cfg := &Config{Scopes: []string{"user:manage:whispers"}}
+	cases := []struct {
+		client  *Client
+		message string
+	}{
+		{newMockClient(&Config{}, "user", http.StatusNoContent, nil), "hello"},
+		{newMockClient(cfg, "app", http.StatusNoContent, nil), "hello"},
+		{newMockClient(cfg, "user", http.StatusNoContent, nil), ""},
+		{newMockClient(cfg, "user", http.StatusNoContent, nil), strings.Repeat("a", WhisperMaxLength+1)},
+		{newMockClient(cfg, "user", http.StatusTooManyRequests, []byte(`{"error":"Too Many Requests","status":429,"message":"rate limited"}`)), "hello"},
+	}
+
+	for _, c := range cases {
+		if err := c.client.SendWhisper("123", "456", c.message); err == nil {
+			t.Error("expected error")
+		}
+	}
+}
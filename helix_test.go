@@ -0,0 +1,558 @@
+package gundyr
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kelr/gundyr/helix"
+)
+
+// A fake helixClient for testing. Embedding the interface satisfies every method, and the
+// methods used by a test are overridden by setting the matching function field.
+type mockHelixClient struct {
+	helixClient
+	getUserBlockList func(opt *helix.GetUserBlockListOpt) (*helix.GetUserBlockListResponse, error)
+	blockUser        func(opt *helix.BlockUserOpt) error
+	unblockUser      func(opt *helix.UnblockUserOpt) error
+
+	getUserExtensions       func() (*helix.GetUserExtensionsResponse, error)
+	getUserActiveExtensions func(opt *helix.GetUserActiveExtensionsOpt) (*helix.GetUserActiveExtensionsResponse, error)
+	updateUserExtensions    func(data *helix.GetUserActiveExtensionsData) (*helix.GetUserActiveExtensionsResponse, error)
+
+	updateDropsEntitlements func(body *helix.UpdateDropsEntitlementsBody) (*helix.UpdateDropsEntitlementsResponse, error)
+
+	getTeams   func(opt *helix.GetTeamsOpt) (*helix.GetTeamsResponse, error)
+	getStreams func(opt *helix.GetStreamsOpt) (*helix.GetStreamsResponse, error)
+	getGames   func(opt *helix.GetGamesOpt) (*helix.GetGamesResponse, error)
+
+	startCommercial func(body *helix.StartCommercialBody) (*helix.StartCommercialResponse, error)
+	startRaid       func(opt *helix.StartRaidOpt) (*helix.StartRaidResponse, error)
+
+	getUsers         func(opt *helix.GetUsersOpt) (*helix.GetUsersResponse, error)
+	getVIPs          func(opt *helix.GetVIPsOpt) (*helix.GetVIPsResponse, error)
+	addChannelVIP    func(opt *helix.ChannelVIPOpt) error
+	removeChannelVIP func(opt *helix.ChannelVIPOpt) error
+
+	getCharityCampaign func(opt *helix.GetCharityCampaignOpt) (*helix.GetCharityCampaignResponse, error)
+	getCreatorGoals    func(opt *helix.GetCreatorGoalsOpt) (*helix.GetCreatorGoalsResponse, error)
+
+	updateShieldModeStatus func(opt *helix.ShieldModeOpt, active bool) (*helix.ShieldModeResponse, error)
+
+	createEventSubSubscription func(body *helix.CreateEventSubSubscriptionBody) (*helix.EventSubSubscriptionsResponse, error)
+	getEventSubSubscriptions   func(opt *helix.GetEventSubSubscriptionsOpt) (*helix.EventSubSubscriptionsResponse, error)
+	deleteEventSubSubscription func(opt *helix.DeleteEventSubSubscriptionOpt) error
+
+	getConduitShards    func(opt *helix.GetConduitShardsOpt) (*helix.GetConduitShardsResponse, error)
+	updateConduitShards func(body *helix.UpdateConduitShardsBody) (*helix.UpdateConduitShardsResponse, error)
+}
+
+func (m *mockHelixClient) GetUserBlockList(opt *helix.GetUserBlockListOpt) (*helix.GetUserBlockListResponse, error) {
+	return m.getUserBlockList(opt)
+}
+
+func (m *mockHelixClient) BlockUser(opt *helix.BlockUserOpt) error {
+	return m.blockUser(opt)
+}
+
+func (m *mockHelixClient) UnblockUser(opt *helix.UnblockUserOpt) error {
+	return m.unblockUser(opt)
+}
+
+func (m *mockHelixClient) GetUserExtensions() (*helix.GetUserExtensionsResponse, error) {
+	return m.getUserExtensions()
+}
+
+func (m *mockHelixClient) GetUserActiveExtensions(opt *helix.GetUserActiveExtensionsOpt) (*helix.GetUserActiveExtensionsResponse, error) {
+	return m.getUserActiveExtensions(opt)
+}
+
+func (m *mockHelixClient) UpdateUserExtensions(data *helix.GetUserActiveExtensionsData) (*helix.GetUserActiveExtensionsResponse, error) {
+	return m.updateUserExtensions(data)
+}
+
+func (m *mockHelixClient) UpdateDropsEntitlements(body *helix.UpdateDropsEntitlementsBody) (*helix.UpdateDropsEntitlementsResponse, error) {
+	return m.updateDropsEntitlements(body)
+}
+
+func (m *mockHelixClient) GetTeams(opt *helix.GetTeamsOpt) (*helix.GetTeamsResponse, error) {
+	return m.getTeams(opt)
+}
+
+func (m *mockHelixClient) GetStreams(opt *helix.GetStreamsOpt) (*helix.GetStreamsResponse, error) {
+	return m.getStreams(opt)
+}
+
+func (m *mockHelixClient) GetGames(opt *helix.GetGamesOpt) (*helix.GetGamesResponse, error) {
+	return m.getGames(opt)
+}
+
+func (m *mockHelixClient) StartCommercial(body *helix.StartCommercialBody) (*helix.StartCommercialResponse, error) {
+	return m.startCommercial(body)
+}
+
+func (m *mockHelixClient) StartRaid(opt *helix.StartRaidOpt) (*helix.StartRaidResponse, error) {
+	return m.startRaid(opt)
+}
+
+func (m *mockHelixClient) GetUsers(opt *helix.GetUsersOpt) (*helix.GetUsersResponse, error) {
+	return m.getUsers(opt)
+}
+
+func (m *mockHelixClient) GetVIPs(opt *helix.GetVIPsOpt) (*helix.GetVIPsResponse, error) {
+	return m.getVIPs(opt)
+}
+
+func (m *mockHelixClient) AddChannelVIP(opt *helix.ChannelVIPOpt) error {
+	return m.addChannelVIP(opt)
+}
+
+func (m *mockHelixClient) RemoveChannelVIP(opt *helix.ChannelVIPOpt) error {
+	return m.removeChannelVIP(opt)
+}
+
+func (m *mockHelixClient) GetCharityCampaign(opt *helix.GetCharityCampaignOpt) (*helix.GetCharityCampaignResponse, error) {
+	return m.getCharityCampaign(opt)
+}
+
+func (m *mockHelixClient) GetCreatorGoals(opt *helix.GetCreatorGoalsOpt) (*helix.GetCreatorGoalsResponse, error) {
+	return m.getCreatorGoals(opt)
+}
+
+func (m *mockHelixClient) UpdateShieldModeStatus(opt *helix.ShieldModeOpt, active bool) (*helix.ShieldModeResponse, error) {
+	return m.updateShieldModeStatus(opt, active)
+}
+
+func (m *mockHelixClient) CreateEventSubSubscription(body *helix.CreateEventSubSubscriptionBody) (*helix.EventSubSubscriptionsResponse, error) {
+	return m.createEventSubSubscription(body)
+}
+
+func (m *mockHelixClient) GetEventSubSubscriptions(opt *helix.GetEventSubSubscriptionsOpt) (*helix.EventSubSubscriptionsResponse, error) {
+	return m.getEventSubSubscriptions(opt)
+}
+
+func (m *mockHelixClient) DeleteEventSubSubscription(opt *helix.DeleteEventSubSubscriptionOpt) error {
+	return m.deleteEventSubSubscription(opt)
+}
+
+func (m *mockHelixClient) GetConduitShards(opt *helix.GetConduitShardsOpt) (*helix.GetConduitShardsResponse, error) {
+	return m.getConduitShards(opt)
+}
+
+func (m *mockHelixClient) UpdateConduitShards(body *helix.UpdateConduitShardsBody) (*helix.UpdateConduitShardsResponse, error) {
+	return m.updateConduitShards(body)
+}
+
+// Write content to a temporary file, returning its path and a function that removes it.
+func writeTempFile(t *testing.T, content string) (string, func()) {
+	dir, err := ioutil.TempDir("", "gundyr")
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(dir, "file")
+	if err := ioutil.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return path, func() {
+		os.RemoveAll(dir)
+	}
+}
+
+// Tests that block list files skip blank lines, comments and duplicate IDs.
+func TestReadBlockListFile(t *testing.T) {
+	path, cleanup := writeTempFile(t, "# spammers\n123\n\n  456  \n123\n#789\n")
+	defer cleanup()
+
+	ids, err := readBlockListFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(ids, []string{"123", "456"}) {
+		t.Error("unexpected IDs:", ids)
+	}
+
+	if _, err := readBlockListFile(path + ".missing"); err == nil {
+		t.Error("expected error for missing file")
+	}
+}
+
+// Tests that SyncBlockList blocks users missing from the block list and unblocks users missing
+// from the file, reading every page of the current block list.
+func TestSyncBlockList(t *testing.T) {
+	path, cleanup := writeTempFile(t, "1\n2\n3\n")
+	defer cleanup()
+
+	var blocked, unblocked []string
+	mock := &mockHelixClient{
+		getUserBlockList: func(opt *helix.GetUserBlockListOpt) (*helix.GetUserBlockListResponse, error) {
+			if opt.After == "" {
+				return &helix.GetUserBlockListResponse{
+					Data:       []helix.GetUserBlockListData{{UserID: "2"}},
+					Pagination: helix.PaginationData{Cursor: "page2"},
+				}, nil
+			}
+			return &helix.GetUserBlockListResponse{
+				Data: []helix.GetUserBlockListData{{UserID: "4"}},
+			}, nil
+		},
+		blockUser: func(opt *helix.BlockUserOpt) error {
+			blocked = append(blocked, opt.TargetUserID)
+			return nil
+		},
+		unblockUser: func(opt *helix.UnblockUserOpt) error {
+			unblocked = append(unblocked, opt.TargetUserID)
+			return nil
+		},
+	}
+	c := &Helix{client: mock}
+
+	diff, err := c.SyncBlockList("1337", path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(diff.Blocked, []string{"1", "3"}) || !reflect.DeepEqual(blocked, diff.Blocked) {
+		t.Error("unexpected blocked users:", diff.Blocked, blocked)
+	}
+	if !reflect.DeepEqual(diff.Unblocked, []string{"4"}) || !reflect.DeepEqual(unblocked, diff.Unblocked) {
+		t.Error("unexpected unblocked users:", diff.Unblocked, unblocked)
+	}
+}
+
+// Tests that SyncBlockList returns the changes made before an error.
+func TestSyncBlockListError(t *testing.T) {
+	path, cleanup := writeTempFile(t, "1\n2\n")
+	defer cleanup()
+
+	mock := &mockHelixClient{
+		getUserBlockList: func(opt *helix.GetUserBlockListOpt) (*helix.GetUserBlockListResponse, error) {
+			return &helix.GetUserBlockListResponse{}, nil
+		},
+		blockUser: func(opt *helix.BlockUserOpt) error {
+			if opt.TargetUserID == "2" {
+				return &helix.APIError{Status: 429, Message: "rate limited"}
+			}
+			return nil
+		},
+	}
+	c := &Helix{client: mock}
+
+	diff, err := c.SyncBlockList("1337", path)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if !reflect.DeepEqual(diff.Blocked, []string{"1"}) {
+		t.Error("unexpected blocked users:", diff.Blocked)
+	}
+}
+
+// Tests that ActivateExtension picks the first free compatible slot, leaves active extensions where
+// they are, and fails without updating when the extension cannot be placed.
+func TestActivateExtension(t *testing.T) {
+	active := func(id string) helix.ActiveExtension {
+		return helix.ActiveExtension{Active: true, ID: id, Version: "1.0.0"}
+	}
+	fullPanels := map[string]helix.ActiveExtension{"1": active("a"), "2": active("b"), "3": active("c")}
+
+	cases := []struct {
+		name      string
+		extension helix.GetUsersExtensionsData
+		active    helix.GetUserActiveExtensionsData
+		slotType  string
+		slot      string
+		updated   bool
+		valid     bool
+	}{
+		{"first free panel", helix.GetUsersExtensionsData{ID: "ext", CanActivate: true, Type: []string{"panel"}},
+			helix.GetUserActiveExtensionsData{Panel: map[string]helix.ActiveExtension{"1": active("a")}}, "panel", "2", true, true},
+		{"panels full falls back to overlay", helix.GetUsersExtensionsData{ID: "ext", CanActivate: true, Type: []string{"panel", "overlay"}},
+			helix.GetUserActiveExtensionsData{Panel: fullPanels}, "overlay", "1", true, true},
+		{"component only", helix.GetUsersExtensionsData{ID: "ext", CanActivate: true, Type: []string{"component"}},
+			helix.GetUserActiveExtensionsData{Component: map[string]helix.ActiveExtension{"1": active("a")}}, "component", "2", true, true},
+		{"already active", helix.GetUsersExtensionsData{ID: "ext", CanActivate: true, Type: []string{"panel", "component"}},
+			helix.GetUserActiveExtensionsData{Component: map[string]helix.ActiveExtension{"2": active("ext")}}, "component", "2", false, true},
+		{"compatible slots full", helix.GetUsersExtensionsData{ID: "ext", CanActivate: true, Type: []string{"panel"}},
+			helix.GetUserActiveExtensionsData{Panel: fullPanels}, "", "", false, false},
+		{"wrong extension type", helix.GetUsersExtensionsData{ID: "ext", CanActivate: true, Type: []string{"mobile"}},
+			helix.GetUserActiveExtensionsData{}, "", "", false, false},
+		{"cannot activate", helix.GetUsersExtensionsData{ID: "ext", CanActivate: false, Type: []string{"panel"}},
+			helix.GetUserActiveExtensionsData{}, "", "", false, false},
+		{"not installed", helix.GetUsersExtensionsData{ID: "other", CanActivate: true, Type: []string{"panel"}},
+			helix.GetUserActiveExtensionsData{}, "", "", false, false},
+	}
+
+	for _, c := range cases {
+		var update *helix.GetUserActiveExtensionsData
+		mock := &mockHelixClient{
+			getUserExtensions: func() (*helix.GetUserExtensionsResponse, error) {
+				return &helix.GetUserExtensionsResponse{Data: []helix.GetUsersExtensionsData{c.extension}}, nil
+			},
+			getUserActiveExtensions: func(opt *helix.GetUserActiveExtensionsOpt) (*helix.GetUserActiveExtensionsResponse, error) {
+				return &helix.GetUserActiveExtensionsResponse{Data: c.active}, nil
+			},
+			updateUserExtensions: func(data *helix.GetUserActiveExtensionsData) (*helix.GetUserActiveExtensionsResponse, error) {
+				update = data
+				return &helix.GetUserActiveExtensionsResponse{}, nil
+			},
+		}
+		h := &Helix{client: mock}
+
+		slotType, slot, err := h.ActivateExtension("1337", "ext")
+		if c.valid && err != nil {
+			t.Errorf("%s: %v", c.name, err)
+			continue
+		}
+		if !c.valid {
+			if err == nil {
+				t.Errorf("%s: expected error", c.name)
+			}
+			if update != nil {
+				t.Errorf("%s: unexpected update", c.name)
+			}
+			continue
+		}
+		if slotType != c.slotType || slot != c.slot {
+			t.Errorf("%s: wanted %s %s, got %s %s", c.name, c.slotType, c.slot, slotType, slot)
+		}
+		if (update != nil) != c.updated {
+			t.Errorf("%s: wanted update %v, got %v", c.name, c.updated, update)
+		}
+		if update != nil {
+			got := (*extensionSlots(update, slotType))[slot]
+			if !got.Active || got.ID != "ext" || len(*extensionSlots(update, slotType)) != 1 {
+				t.Errorf("%s: unexpected update: %v", c.name, update)
+			}
+		}
+	}
+}
+
+// Create a Helix that records the backoffs it sleeps for instead of sleeping.
+func newSleeplessHelix(client helixClient, slept *[]time.Duration) *Helix {
+	return &Helix{
+		client: client,
+		sleep: func(d time.Duration) {
+			*slept = append(*slept, d)
+		},
+	}
+}
+
+// Tests that UpdateEntitlements updates entitlements in batches and retries only the failed ones.
+func TestUpdateEntitlements(t *testing.T) {
+	var ids []string
+	for i := 0; i < 250; i++ {
+		ids = append(ids, strconv.Itoa(i))
+	}
+
+	var batches []int
+	failedOnce := map[string]bool{"5": true, "120": true}
+	mock := &mockHelixClient{
+		updateDropsEntitlements: func(body *helix.UpdateDropsEntitlementsBody) (*helix.UpdateDropsEntitlementsResponse, error) {
+			batches = append(batches, len(body.EntitlementIDs))
+			success := helix.UpdateDropsEntitlementsData{Status: "SUCCESS"}
+			failed := helix.UpdateDropsEntitlementsData{Status: "UPDATE_FAILED"}
+			for _, id := range body.EntitlementIDs {
+				if failedOnce[id] {
+					delete(failedOnce, id)
+					failed.IDs = append(failed.IDs, id)
+					continue
+				}
+				success.IDs = append(success.IDs, id)
+			}
+			return &helix.UpdateDropsEntitlementsResponse{Data: []helix.UpdateDropsEntitlementsData{success, failed}}, nil
+		},
+	}
+	var slept []time.Duration
+	c := newSleeplessHelix(mock, &slept)
+
+	results, err := c.UpdateEntitlements(ids, "FULFILLED")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(batches, []int{100, 1, 100, 1, 50}) {
+		t.Error("unexpected batches:", batches)
+	}
+	if !reflect.DeepEqual(slept, []time.Duration{entitlementBackoff, entitlementBackoff}) {
+		t.Error("unexpected backoff:", slept)
+	}
+	if len(results) != 250 || results["5"] != "SUCCESS" || results["120"] != "SUCCESS" {
+		t.Error("unexpected results:", len(results), results["5"], results["120"])
+	}
+}
+
+// Tests that UpdateEntitlements retries retryable errors with exponential backoff until it runs out of
+// attempts, and returns other errors immediately.
+func TestUpdateEntitlementsErrors(t *testing.T) {
+	cases := []struct {
+		err      error
+		attempts int
+		slept    []time.Duration
+	}{
+		{&helix.APIError{Status: http.StatusServiceUnavailable}, entitlementAttempts, []time.Duration{entitlementBackoff, 2 * entitlementBackoff, 4 * entitlementBackoff}},
+		{&helix.APIError{Status: http.StatusTooManyRequests}, entitlementAttempts, []time.Duration{entitlementBackoff, 2 * entitlementBackoff, 4 * entitlementBackoff}},
+		{&helix.APIError{Status: http.StatusBadRequest}, 1, nil},
+		{errors.New("connection reset"), 1, nil},
+	}
+
+	for _, tc := range cases {
+		attempts := 0
+		mock := &mockHelixClient{
+			updateDropsEntitlements: func(body *helix.UpdateDropsEntitlementsBody) (*helix.UpdateDropsEntitlementsResponse, error) {
+				attempts++
+				return nil, tc.err
+			},
+		}
+		var slept []time.Duration
+		c := newSleeplessHelix(mock, &slept)
+
+		if _, err := c.UpdateEntitlements([]string{"1"}, "FULFILLED"); err != tc.err {
+			t.Errorf("wanted: %v\n got: %v\n", tc.err, err)
+		}
+		if attempts != tc.attempts || !reflect.DeepEqual(slept, tc.slept) {
+			t.Errorf("%v: got %d attempts and backoff %v", tc.err, attempts, slept)
+		}
+	}
+}
+
+// Tests that TeamLiveStatus joins the live streams of team members onto every member in team order.
+func TestTeamLiveStatus(t *testing.T) {
+	mock := &mockHelixClient{
+		getTeams: func(opt *helix.GetTeamsOpt) (*helix.GetTeamsResponse, error) {
+			if opt.Name != "livecoders" {
+				return &helix.GetTeamsResponse{}, nil
+			}
+			return &helix.GetTeamsResponse{Data: []helix.GetTeamsData{{
+				TeamName: "livecoders",
+				Users: []helix.TeamMember{
+					{UserID: "1", UserLogin: "one"},
+					{UserID: "2", UserLogin: "two"},
+					{UserID: "3", UserLogin: "three"},
+				},
+			}}}, nil
+		},
+		getStreams: func(opt *helix.GetStreamsOpt) (*helix.GetStreamsResponse, error) {
+			if !reflect.DeepEqual(opt.UserID, []string{"1", "2", "3"}) {
+				t.Error("unexpected user IDs:", opt.UserID)
+			}
+			resp := new(helix.GetStreamsResponse)
+			err := json.Unmarshal([]byte(`{"data":[{"user_id":"3","type":"live","title":"coding","game_id":"1469308723","viewer_count":42}]}`), resp)
+			return resp, err
+		},
+	}
+	c := &Helix{client: mock}
+
+	statuses, err := c.TeamLiveStatus("livecoders")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []TeamMemberStatus{
+		{UserID: "1", UserLogin: "one"},
+		{UserID: "2", UserLogin: "two"},
+		{UserID: "3", UserLogin: "three", Live: true, Title: "coding", GameID: "1469308723", ViewerCount: 42},
+	}
+	if !reflect.DeepEqual(statuses, want) {
+		t.Error("unexpected statuses:", statuses)
+	}
+
+	if _, err := c.TeamLiveStatus("missing"); err == nil {
+		t.Error("expected error for missing team")
+	}
+}
+
+// Tests that fetchBatches splits IDs into batches, caps concurrent requests and merges results in batch order.
+func TestFetchBatches(t *testing.T) {
+	ids := make([]string, 450)
+	for i := range ids {
+		ids[i] = strconv.Itoa(i)
+	}
+
+	var mu sync.Mutex
+	running, maxRunning := 0, 0
+	var merged []string
+	err := fetchBatches(ids, func(batch []string) (interface{}, error) {
+		mu.Lock()
+		running++
+		if running > maxRunning {
+			maxRunning = running
+		}
+		mu.Unlock()
+		time.Sleep(10 * time.Millisecond)
+		mu.Lock()
+		running--
+		mu.Unlock()
+		if len(batch) > helix.MaxQueryValues {
+			t.Error("batch too large:", len(batch))
+		}
+		return batch, nil
+	}, func(result interface{}) {
+		merged = append(merged, result.([]string)...)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(merged, ids) {
+		t.Error("results not merged in batch order")
+	}
+	if maxRunning > maxBatchWorkers {
+		t.Errorf("wanted at most %d concurrent batches, got %d", maxBatchWorkers, maxRunning)
+	}
+
+	// The first failed batch is returned and nothing is merged.
+	failure := errors.New("failure")
+	calls := 0
+	err = fetchBatches(ids, func(batch []string) (interface{}, error) {
+		if batch[0] == "200" {
+			return nil, failure
+		}
+		return batch, nil
+	}, func(result interface{}) {
+		calls++
+	})
+	if err != failure || calls != 0 {
+		t.Errorf("wanted error %v and no merges, got %v and %d merges", failure, err, calls)
+	}
+
+	if err := fetchBatches(nil, nil, nil); err != nil {
+		t.Error("unexpected error for no IDs:", err)
+	}
+}
+
+// Tests that GetGamesByID requests every game and returns the merged results.
+func TestGetGamesByID(t *testing.T) {
+	ids := make([]string, 150)
+	for i := range ids {
+		ids[i] = strconv.Itoa(i)
+	}
+	mock := &mockHelixClient{
+		getGames: func(opt *helix.GetGamesOpt) (*helix.GetGamesResponse, error) {
+			resp := &helix.GetGamesResponse{}
+			for _, id := range opt.ID {
+				resp.Data = append(resp.Data, helix.GetGamesData{ID: id})
+			}
+			return resp, nil
+		},
+	}
+	c := &Helix{client: mock}
+
+	games, err := c.GetGamesByID(ids)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(games) != len(ids) || games[0].ID != "0" || games[149].ID != "149" {
+		t.Error("unexpected games:", len(games))
+	}
+
+	failure := errors.New("failure")
+	mock.getGames = func(opt *helix.GetGamesOpt) (*helix.GetGamesResponse, error) {
+		return nil, failure
+	}
+	if games, err := c.GetGamesByID(ids); err != failure || games != nil {
+		t.Errorf("wanted error %v, got %v", failure, err)
+	}
+}
@@ -0,0 +1,143 @@
+package gundyr
+
+import (
+	"errors"
+	"fmt"
+	"github.com/kelr/gundyr/helix"
+	"github.com/kelr/gundyr/pubsub"
+	"sync"
+	"time"
+)
+
+// ResolveAutoModMessage allows or denies a chat message held by AutoMod, as reported by a PubSub chat mod action.
+// moderatorID must be the user of the access token.
+// Requires scope: moderator:manage:automod
+func (c *Helix) ResolveAutoModMessage(moderatorID string, event *pubsub.ChatModActionsData, allow bool) error {
+	if event == nil || !event.FromAutomod || event.MsgID == "" {
+		return errors.New("Helix: Chat mod action is not a message held by AutoMod.")
+	}
+
+	action := "DENY"
+	if allow {
+		action = "ALLOW"
+	}
+	return c.client.ManageHeldAutoModMessage(&helix.ManageHeldAutoModMessageBody{
+		UserID: moderatorID,
+		MsgID:  event.MsgID,
+		Action: action,
+	})
+}
+
+// ShieldGuard activates Shield Mode in a broadcaster's chat when a burst of bans occurs.
+// Pass its HandleModAction method to pubsub.Client.ListenChatModActions.
+type ShieldGuard struct {
+	BroadcasterID string
+	ModeratorID   string
+	Threshold     int
+	Window        time.Duration
+
+	// OnActivate is called after Shield Mode is activated, if set.
+	OnActivate func(*helix.ShieldModeData)
+
+	client helixClient
+	bans   []time.Time
+	mu     *sync.Mutex
+}
+
+// NewShieldGuard returns a ShieldGuard that activates Shield Mode in the chat of broadcasterID when threshold
+// or more bans happen within window. moderatorID must be the user of the access token.
+// Returns an error if threshold or window is not positive.
+// Requires scope: moderator:manage:shield_mode
+func (c *Helix) NewShieldGuard(broadcasterID string, moderatorID string, threshold int, window time.Duration) (*ShieldGuard, error) {
+	if threshold <= 0 || window <= 0 {
+		return nil, errors.New("Shield guard threshold and window must be positive")
+	}
+	return &ShieldGuard{
+		BroadcasterID: broadcasterID,
+		ModeratorID:   moderatorID,
+		Threshold:     threshold,
+		Window:        window,
+		client:        c.client,
+		mu:            &sync.Mutex{},
+	}, nil
+}
+
+// HandleModAction records ban events and activates Shield Mode once the threshold is crossed.
+// The window is cleared after activating so a new burst is needed to trigger it again.
+func (g *ShieldGuard) HandleModAction(event *pubsub.ChatModActionsData) {
+	if event == nil || event.ModerationAction != "ban" {
+		return
+	}
+	if !g.recordBan(time.Now()) {
+		return
+	}
+
+	response, err := g.client.UpdateShieldModeStatus(&helix.ShieldModeOpt{
+		BroadcasterID: g.BroadcasterID,
+		ModeratorID:   g.ModeratorID,
+	}, true)
+	if err != nil {
+		fmt.Println("Shield guard failed to activate shield mode:", err)
+		return
+	}
+	if g.OnActivate != nil && len(response.Data) > 0 {
+		g.OnActivate(&response.Data[0])
+	}
+}
+
+// recordBan adds a ban at now to the sliding window and returns whether the threshold has been reached.
+func (g *ShieldGuard) recordBan(now time.Time) bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	cutoff := now.Add(-g.Window)
+	kept := g.bans[:0]
+	for _, t := range g.bans {
+		if t.After(cutoff) {
+			kept = append(kept, t)
+		}
+	}
+	g.bans = append(kept, now)
+
+	if g.Threshold <= 0 || len(g.bans) < g.Threshold {
+		return false
+	}
+	g.bans = nil
+	return true
+}
+
+// GetModeratedChannels returns every channel that userID has moderator privileges in, which are the
+// channels the account can take moderation actions on besides its own.
+// Requires scope: user:read:moderated_channels
+func (c *Helix) GetModeratedChannels(userID string) ([]helix.GetModeratedChannelsData, error) {
+	var channels []helix.GetModeratedChannelsData
+	opt := &helix.GetModeratedChannelsOpt{
+		UserID: userID,
+		First:  100,
+	}
+
+	response, err := c.client.GetModeratedChannels(opt)
+	if err != nil {
+		return nil, err
+	}
+
+	// Drain all the channels by checking each page until there are none left.
+	for len(response.Data) > 0 {
+		channels = append(channels, response.Data...)
+		if response.Pagination.Cursor == "" {
+			break
+		}
+
+		opt = &helix.GetModeratedChannelsOpt{
+			UserID: userID,
+			First:  100,
+			After:  response.Pagination.Cursor,
+		}
+
+		response, err = c.client.GetModeratedChannels(opt)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return channels, nil
+}
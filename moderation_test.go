@@ -0,0 +1,88 @@
+package gundyr
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kelr/gundyr/helix"
+	"github.com/kelr/gundyr/pubsub"
+)
+
+// Tests that NewShieldGuard rejects a threshold or window that could never trigger.
+func TestNewShieldGuard(t *testing.T) {
+	c := &Helix{client: &mockHelixClient{}}
+	if _, err := c.NewShieldGuard("1337", "1337", 0, time.Minute); err == nil {
+		t.Error("expected error for zero threshold")
+	}
+	if _, err := c.NewShieldGuard("1337", "1337", 3, 0); err == nil {
+		t.Error("expected error for zero window")
+	}
+	if _, err := c.NewShieldGuard("1337", "1337", 3, time.Minute); err != nil {
+		t.Error(err)
+	}
+}
+
+// Tests that bans only count toward the threshold while they are within the window,
+// and that the window is cleared after the threshold is reached.
+func TestShieldGuardWindow(t *testing.T) {
+	c := &Helix{client: &mockHelixClient{}}
+	g, err := c.NewShieldGuard("1337", "1337", 3, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	start := time.Date(2022, 1, 1, 12, 0, 0, 0, time.UTC)
+	steps := []struct {
+		after time.Duration
+		want  bool
+	}{
+		{0, false},
+		{30 * time.Second, false},
+		// The first ban has left the window.
+		{time.Minute, false},
+		{80 * time.Second, true},
+		// The window was cleared, so a new burst is needed.
+		{81 * time.Second, false},
+		{82 * time.Second, false},
+		{83 * time.Second, true},
+	}
+	for _, step := range steps {
+		if got := g.recordBan(start.Add(step.after)); got != step.want {
+			t.Errorf("ban at %v: wanted: %v\n got: %v\n", step.after, step.want, got)
+		}
+	}
+}
+
+// Tests that HandleModAction ignores other actions and activates Shield Mode once the threshold is reached.
+func TestShieldGuardHandleModAction(t *testing.T) {
+	activations := 0
+	mock := &mockHelixClient{
+		updateShieldModeStatus: func(opt *helix.ShieldModeOpt, active bool) (*helix.ShieldModeResponse, error) {
+			if opt.BroadcasterID != "1337" || opt.ModeratorID != "42" || !active {
+				t.Error("unexpected shield mode update:", opt, active)
+			}
+			activations++
+			return &helix.ShieldModeResponse{Data: []helix.ShieldModeData{{IsActive: true}}}, nil
+		},
+	}
+	c := &Helix{client: mock}
+	g, err := c.NewShieldGuard("1337", "42", 2, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var activated *helix.ShieldModeData
+	g.OnActivate = func(data *helix.ShieldModeData) {
+		activated = data
+	}
+
+	g.HandleModAction(nil)
+	g.HandleModAction(&pubsub.ChatModActionsData{ModerationAction: "timeout"})
+	g.HandleModAction(&pubsub.ChatModActionsData{ModerationAction: "ban"})
+	if activations != 0 {
+		t.Fatal("activated before the threshold")
+	}
+	g.HandleModAction(&pubsub.ChatModActionsData{ModerationAction: "ban"})
+	if activations != 1 || activated == nil || !activated.IsActive {
+		t.Error("shield mode not activated at the threshold")
+	}
+}
@@ -106,7 +106,7 @@ func (c *Client) Connect() error {
 		c.mu.Unlock()
 		fmt.Println("PubSub Client connected")
 	} else {
-		errors.New("PubSub Client is already connected")
+		return errors.New("PubSub Client is already connected")
 	}
 	return nil
 }
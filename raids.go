@@ -0,0 +1,120 @@
+package gundyr
+
+import (
+	"errors"
+	"github.com/kelr/gundyr/helix"
+	"sort"
+)
+
+const (
+	defaultRaidMaxPages = 10
+)
+
+// RaidTargetOpt defines the options available for FindRaidTargets.
+type RaidTargetOpt struct {
+	// BroadcasterID is the channel raiding. It is never returned as a target, and channels
+	// on its block list are skipped.
+	BroadcasterID string
+
+	// GameID and Language filter the live streams considered.
+	GameID   []string
+	Language []string
+
+	// MinViewers and MaxViewers bound the viewer count of targets. A MaxViewers of 0 means no upper bound.
+	MinViewers int
+	MaxViewers int
+
+	// MaxPages limits the number of pages of streams checked. Defaults to 10.
+	MaxPages int
+
+	// Score ranks targets, highest first. Defaults to ranking by viewer count.
+	Score func(*helix.GetStreamsData) float64
+}
+
+// FindRaidTargets returns live channels suitable for a raid, ranked by opt.Score.
+// Streams are paged through in decreasing viewer count order until they fall below opt.MinViewers
+// or opt.MaxPages pages have been checked.
+// Requires scope: user:read:blocked_users
+func (c *Helix) FindRaidTargets(opt *RaidTargetOpt) ([]helix.GetStreamsData, error) {
+	maxPages := opt.MaxPages
+	if maxPages <= 0 {
+		maxPages = defaultRaidMaxPages
+	}
+	score := opt.Score
+	if score == nil {
+		score = func(s *helix.GetStreamsData) float64 {
+			return float64(s.ViewerCount)
+		}
+	}
+
+	blockList, err := c.GetBlockList(opt.BroadcasterID)
+	if err != nil {
+		return nil, err
+	}
+	blocked := make(map[string]bool, len(blockList))
+	for _, id := range blockList {
+		blocked[id] = true
+	}
+
+	var targets []helix.GetStreamsData
+	cursor := ""
+	for page := 0; page < maxPages; page++ {
+		response, err := c.client.GetStreams(&helix.GetStreamsOpt{
+			GameID:   opt.GameID,
+			Language: opt.Language,
+			First:    100,
+			After:    cursor,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		belowMin := false
+		for _, stream := range response.Data {
+			if stream.ViewerCount < opt.MinViewers {
+				belowMin = true
+				break
+			}
+			if opt.MaxViewers > 0 && stream.ViewerCount > opt.MaxViewers {
+				continue
+			}
+			if stream.UserID == opt.BroadcasterID || blocked[stream.UserID] || stream.Type != "live" {
+				continue
+			}
+			targets = append(targets, stream)
+		}
+
+		// Streams are sorted by viewer count, so the remaining pages are all below the minimum.
+		if belowMin || len(response.Data) == 0 || response.Pagination.Cursor == "" {
+			break
+		}
+		cursor = response.Pagination.Cursor
+	}
+
+	sort.SliceStable(targets, func(i, j int) bool {
+		return score(&targets[i]) > score(&targets[j])
+	})
+	return targets, nil
+}
+
+// RaidTopTarget finds raid targets with FindRaidTargets and starts a raid on the highest ranked one.
+// Returns the stream of the raided channel.
+// Requires scopes: user:read:blocked_users and channel:manage:raids
+func (c *Helix) RaidTopTarget(opt *RaidTargetOpt) (*helix.GetStreamsData, error) {
+	targets, err := c.FindRaidTargets(opt)
+	if err != nil {
+		return nil, err
+	}
+	if len(targets) == 0 {
+		return nil, errors.New("Raid: no suitable raid targets found")
+	}
+
+	_, err = c.client.StartRaid(&helix.StartRaidOpt{
+		FromBroadcasterID: opt.BroadcasterID,
+		ToBroadcasterID:   targets[0].UserID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &targets[0], nil
+}
@@ -0,0 +1,128 @@
+package gundyr
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/kelr/gundyr/helix"
+)
+
+// Returns a mock client serving two pages of streams sorted by viewer count and a block list of user 5.
+func newRaidMock(t *testing.T) *mockHelixClient {
+	pages := map[string]*helix.GetStreamsResponse{
+		"": {
+			Data: []helix.GetStreamsData{
+				{UserID: "1", Type: "live", ViewerCount: 900, Language: "en"},
+				{UserID: "2", Type: "live", ViewerCount: 400, Language: "de"},
+				{UserID: "1337", Type: "live", ViewerCount: 300},
+				{UserID: "3", Type: "", ViewerCount: 250},
+			},
+			Pagination: helix.PaginationData{Cursor: "page2"},
+		},
+		"page2": {
+			Data: []helix.GetStreamsData{
+				{UserID: "4", Type: "live", ViewerCount: 200, Language: "fr"},
+				{UserID: "5", Type: "live", ViewerCount: 150},
+				{UserID: "6", Type: "live", ViewerCount: 40},
+				{UserID: "7", Type: "live", ViewerCount: 5},
+			},
+		},
+	}
+	return &mockHelixClient{
+		getUserBlockList: func(opt *helix.GetUserBlockListOpt) (*helix.GetUserBlockListResponse, error) {
+			if opt.BroadcasterID != "1337" {
+				t.Error("unexpected broadcaster:", opt.BroadcasterID)
+			}
+			return &helix.GetUserBlockListResponse{Data: []helix.GetUserBlockListData{{UserID: "5"}}}, nil
+		},
+		getStreams: func(opt *helix.GetStreamsOpt) (*helix.GetStreamsResponse, error) {
+			page, ok := pages[opt.After]
+			if !ok {
+				t.Error("unexpected cursor:", opt.After)
+				return &helix.GetStreamsResponse{}, nil
+			}
+			return page, nil
+		},
+	}
+}
+
+// Returns the user IDs of streams in order.
+func streamUserIDs(streams []helix.GetStreamsData) []string {
+	ids := []string{}
+	for _, s := range streams {
+		ids = append(ids, s.UserID)
+	}
+	return ids
+}
+
+// Tests that FindRaidTargets filters out the raider, blocked, offline and out of range channels,
+// stops paging at the minimum viewer count and ranks by the score.
+func TestFindRaidTargets(t *testing.T) {
+	tests := []struct {
+		name string
+		opt  RaidTargetOpt
+		want []string
+	}{
+		{"default", RaidTargetOpt{}, []string{"1", "2", "4", "6", "7"}},
+		{"viewer range", RaidTargetOpt{MinViewers: 100, MaxViewers: 500}, []string{"2", "4"}},
+		{"max pages", RaidTargetOpt{MaxPages: 1}, []string{"1", "2"}},
+		{"score", RaidTargetOpt{MinViewers: 100, Score: func(s *helix.GetStreamsData) float64 {
+			return -float64(s.ViewerCount)
+		}}, []string{"4", "2", "1"}},
+	}
+	for _, tc := range tests {
+		mock := newRaidMock(t)
+		pages := 0
+		getStreams := mock.getStreams
+		mock.getStreams = func(opt *helix.GetStreamsOpt) (*helix.GetStreamsResponse, error) {
+			pages++
+			return getStreams(opt)
+		}
+		c := &Helix{client: mock}
+
+		tc.opt.BroadcasterID = "1337"
+		targets, err := c.FindRaidTargets(&tc.opt)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := streamUserIDs(targets); !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("%s: wanted: %v\n got: %v\n", tc.name, tc.want, got)
+		}
+		if tc.opt.MaxPages == 1 && pages != 1 {
+			t.Errorf("%s: wanted 1 page, got %d", tc.name, pages)
+		}
+	}
+}
+
+// Tests that RaidTopTarget raids the highest ranked target and fails without targets.
+func TestRaidTopTarget(t *testing.T) {
+	mock := newRaidMock(t)
+	var raided *helix.StartRaidOpt
+	mock.startRaid = func(opt *helix.StartRaidOpt) (*helix.StartRaidResponse, error) {
+		raided = opt
+		return &helix.StartRaidResponse{}, nil
+	}
+	c := &Helix{client: mock}
+
+	target, err := c.RaidTopTarget(&RaidTargetOpt{BroadcasterID: "1337", MaxViewers: 500})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if target.UserID != "2" || !reflect.DeepEqual(raided, &helix.StartRaidOpt{FromBroadcasterID: "1337", ToBroadcasterID: "2"}) {
+		t.Error("unexpected raid:", target, raided)
+	}
+
+	raided = nil
+	if _, err := c.RaidTopTarget(&RaidTargetOpt{BroadcasterID: "1337", MinViewers: 1000}); err == nil || raided != nil {
+		t.Error("expected error without a raid for no targets")
+	}
+
+	failure := errors.New("failure")
+	mock.getUserBlockList = func(opt *helix.GetUserBlockListOpt) (*helix.GetUserBlockListResponse, error) {
+		return nil, failure
+	}
+	if _, err := c.FindRaidTargets(&RaidTargetOpt{BroadcasterID: "1337"}); err != failure {
+		t.Errorf("wanted: %v\n got: %v\n", failure, err)
+	}
+}
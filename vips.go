@@ -0,0 +1,148 @@
+package gundyr
+
+import (
+	"errors"
+	"github.com/kelr/gundyr/helix"
+	"strings"
+)
+
+// VIPDiff represents the changes made to a channel's VIPs by SyncVIPs.
+type VIPDiff struct {
+	Added   []string
+	Removed []string
+}
+
+// LoginsToIDs converts any number of usernames to user IDs, looking them up concurrently in batches of 100.
+// Returns a map of lowercase login to user ID. Logins that do not exist are missing from the map.
+func (c *Helix) LoginsToIDs(logins []string) (map[string]string, error) {
+	ids := make(map[string]string, len(logins))
+	err := fetchBatches(logins, func(batch []string) (interface{}, error) {
+		response, err := c.client.GetUsers(&helix.GetUsersOpt{
+			Login: batch,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return response.Data, nil
+	}, func(result interface{}) {
+		for _, u := range result.([]helix.GetUsersData) {
+			ids[strings.ToLower(u.Login)] = u.ID
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// GetVIPs returns the user IDs of all of the VIPs of broadcasterID.
+// Requires scope: channel:read:vips or channel:manage:vips
+func (c *Helix) GetVIPs(broadcasterID string) ([]string, error) {
+	var vips []string
+	opt := &helix.GetVIPsOpt{
+		BroadcasterID: broadcasterID,
+		First:         100,
+	}
+
+	response, err := c.client.GetVIPs(opt)
+	if err != nil {
+		return nil, err
+	}
+
+	// Drain all the VIPs by checking each page until there are none left.
+	for len(response.Data) > 0 {
+		for _, d := range response.Data {
+			vips = append(vips, d.UserID)
+		}
+		if response.Pagination.Cursor == "" {
+			break
+		}
+
+		opt = &helix.GetVIPsOpt{
+			BroadcasterID: broadcasterID,
+			First:         100,
+			After:         response.Pagination.Cursor,
+		}
+
+		response, err = c.client.GetVIPs(opt)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return vips, nil
+}
+
+// SyncVIPs makes the VIPs of broadcasterID match the users in logins.
+// VIPs missing from logins are removed first to free their slots, then the remaining users are added.
+// Returns the user IDs that were actually added and removed, including those changed before an error occurred.
+// If the broadcaster runs out of VIP slots, helix.ErrNoVIPSlots is returned, and if the broadcaster
+// has not unlocked VIPs, helix.ErrVIPUnavailable is returned.
+// Requires scope: channel:manage:vips
+func (c *Helix) SyncVIPs(broadcasterID string, logins []string) (*VIPDiff, error) {
+	ids, err := c.LoginsToIDs(logins)
+	if err != nil {
+		return nil, err
+	}
+
+	var wanted []string
+	for _, login := range logins {
+		id, ok := ids[strings.ToLower(login)]
+		if !ok {
+			return nil, errors.New("User: " + login + " not found")
+		}
+		wanted = append(wanted, id)
+	}
+
+	current, err := c.GetVIPs(broadcasterID)
+	if err != nil {
+		return nil, err
+	}
+
+	isWanted := make(map[string]bool, len(wanted))
+	for _, id := range wanted {
+		isWanted[id] = true
+	}
+	isVIP := make(map[string]bool, len(current))
+	for _, id := range current {
+		isVIP[id] = true
+	}
+
+	diff := new(VIPDiff)
+	for _, id := range current {
+		if isWanted[id] {
+			continue
+		}
+		err := c.client.RemoveChannelVIP(&helix.ChannelVIPOpt{
+			BroadcasterID: broadcasterID,
+			UserID:        id,
+		})
+		// A user who is no longer a VIP needs no change, so only successful removals are recorded.
+		if err == helix.ErrNotVIP {
+			continue
+		}
+		if err != nil {
+			return diff, err
+		}
+		diff.Removed = append(diff.Removed, id)
+	}
+
+	for _, id := range wanted {
+		if isVIP[id] {
+			continue
+		}
+		err := c.client.AddChannelVIP(&helix.ChannelVIPOpt{
+			BroadcasterID: broadcasterID,
+			UserID:        id,
+		})
+		isVIP[id] = true
+		// A user who is already a VIP needs no change, so only successful additions are recorded.
+		if err == helix.ErrAlreadyVIP {
+			continue
+		}
+		if err != nil {
+			return diff, err
+		}
+		diff.Added = append(diff.Added, id)
+	}
+	return diff, nil
+}
@@ -0,0 +1,84 @@
+package gundyr
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/kelr/gundyr/helix"
+)
+
+// Returns a mock client where user logins map to their lowercase IDs prefixed with "id-", the broadcaster's
+// current VIPs are vips, removing a VIP returns removeErr and adding a VIP returns addErr. Removed and added
+// user IDs are recorded in order.
+func newVIPMock(vips []string, removeErr error, addErr error, removed *[]string, added *[]string) *mockHelixClient {
+	return &mockHelixClient{
+		getUsers: func(opt *helix.GetUsersOpt) (*helix.GetUsersResponse, error) {
+			resp := &helix.GetUsersResponse{}
+			for _, login := range opt.Login {
+				login = strings.ToLower(login)
+				resp.Data = append(resp.Data, helix.GetUsersData{ID: "id-" + login, Login: login})
+			}
+			return resp, nil
+		},
+		getVIPs: func(opt *helix.GetVIPsOpt) (*helix.GetVIPsResponse, error) {
+			resp := &helix.GetVIPsResponse{}
+			for _, id := range vips {
+				resp.Data = append(resp.Data, helix.GetVIPsData{UserID: id})
+			}
+			return resp, nil
+		},
+		removeChannelVIP: func(opt *helix.ChannelVIPOpt) error {
+			*removed = append(*removed, opt.UserID)
+			return removeErr
+		},
+		addChannelVIP: func(opt *helix.ChannelVIPOpt) error {
+			if addErr != nil && addErr != helix.ErrAlreadyVIP {
+				return addErr
+			}
+			*added = append(*added, opt.UserID)
+			return addErr
+		},
+	}
+}
+
+// Tests that SyncVIPs removes unwanted VIPs before adding missing ones and reports the changes,
+// including the changes made before adding a VIP fails. Users already in the wanted state are not reported.
+func TestSyncVIPs(t *testing.T) {
+	tests := []struct {
+		name      string
+		removeErr error
+		addErr    error
+		wantErr   error
+		diff      *VIPDiff
+		removed   []string
+		added     []string
+	}{
+		{"success", nil, nil, nil, &VIPDiff{Removed: []string{"id-old"}, Added: []string{"id-new", "id-newer"}},
+			[]string{"id-old"}, []string{"id-new", "id-newer"}},
+		{"already vip", nil, helix.ErrAlreadyVIP, nil, &VIPDiff{Removed: []string{"id-old"}},
+			[]string{"id-old"}, []string{"id-new", "id-newer"}},
+		{"not vip", helix.ErrNotVIP, nil, nil, &VIPDiff{Added: []string{"id-new", "id-newer"}},
+			[]string{"id-old"}, []string{"id-new", "id-newer"}},
+		{"no slots", nil, helix.ErrNoVIPSlots, helix.ErrNoVIPSlots, &VIPDiff{Removed: []string{"id-old"}},
+			[]string{"id-old"}, nil},
+		{"unavailable", nil, helix.ErrVIPUnavailable, helix.ErrVIPUnavailable, &VIPDiff{Removed: []string{"id-old"}},
+			[]string{"id-old"}, nil},
+	}
+	for _, tc := range tests {
+		var removed, added []string
+		mock := newVIPMock([]string{"id-kept", "id-old"}, tc.removeErr, tc.addErr, &removed, &added)
+		c := &Helix{client: mock}
+
+		diff, err := c.SyncVIPs("1337", []string{"kept", "New", "newer"})
+		if wantErr := tc.wantErr; err != wantErr {
+			t.Errorf("%s: wanted: %v\n got: %v\n", tc.name, wantErr, err)
+		}
+		if !reflect.DeepEqual(diff, tc.diff) {
+			t.Errorf("%s: wanted: %v\n got: %v\n", tc.name, tc.diff, diff)
+		}
+		if !reflect.DeepEqual(removed, tc.removed) || !reflect.DeepEqual(added, tc.added) {
+			t.Errorf("%s: removed %v and added %v", tc.name, removed, added)
+		}
+	}
+}